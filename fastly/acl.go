@@ -7,15 +7,17 @@ import (
 	"time"
 )
 
+// ACL represents an access control list attached to a service version. ACL
+// entries are managed separately, see ACLEntry.
 type ACL struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name      string     `mapstructure:"name"`
-	ID        string     `mapstructure:"id"`
-	CreatedAt *time.Time `mapstructure:"created_at"`
-	UpdatedAt *time.Time `mapstructure:"updated_at"`
-	DeletedAt *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name      string     `mapstructure:"name" json:"name"`
+	ID        string     `mapstructure:"id" json:"id"`
+	CreatedAt *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // ACLsByName is a sortable list of ACLs.
@@ -61,6 +63,30 @@ func (c *Client) ListACLs(i *ListACLsInput) ([]*ACL, error) {
 	return as, nil
 }
 
+// ListServiceACLsInput is used as input to the ListServiceACLs function.
+type ListServiceACLsInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+}
+
+// ListServiceACLs returns the list of ACLs for the active version of the
+// service. It returns ErrNoActiveVersion if no version is active.
+func (c *Client) ListServiceACLs(i *ListServiceACLsInput) ([]*ACL, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	v, err := c.ActiveVersion(&ActiveVersionInput{ServiceID: i.ServiceID})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ListACLs(&ListACLsInput{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: v.Number,
+	})
+}
+
 // CreateACLInput is used as input to the CreateACL function.
 type CreateACLInput struct {
 	// ServiceID is the ID of the service (required).
@@ -73,6 +99,7 @@ type CreateACLInput struct {
 	Name string `url:"name"`
 }
 
+// CreateACL creates a new ACL on the given service version.
 func (c *Client) CreateACL(i *CreateACLInput) (*ACL, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
@@ -82,6 +109,10 @@ func (c *Client) CreateACL(i *CreateACLInput) (*ACL, error) {
 		return nil, ErrMissingServiceVersion
 	}
 
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/acl", i.ServiceID, i.ServiceVersion)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
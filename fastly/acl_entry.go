@@ -2,6 +2,7 @@ package fastly
 
 import (
 	"fmt"
+	"io"
 	"net/url"
 	"sort"
 	"strconv"
@@ -11,17 +12,17 @@ import (
 )
 
 type ACLEntry struct {
-	ServiceID string `mapstructure:"service_id"`
-	ACLID     string `mapstructure:"acl_id"`
-
-	ID        string     `mapstructure:"id"`
-	IP        string     `mapstructure:"ip"`
-	Subnet    *int       `mapstructure:"subnet"`
-	Negated   bool       `mapstructure:"negated"`
-	Comment   string     `mapstructure:"comment"`
-	CreatedAt *time.Time `mapstructure:"created_at"`
-	UpdatedAt *time.Time `mapstructure:"updated_at"`
-	DeletedAt *time.Time `mapstructure:"deleted_at"`
+	ServiceID string `mapstructure:"service_id" json:"service_id"`
+	ACLID     string `mapstructure:"acl_id" json:"acl_id"`
+
+	ID        string     `mapstructure:"id" json:"id"`
+	IP        string     `mapstructure:"ip" json:"ip"`
+	Subnet    *int       `mapstructure:"subnet" json:"subnet"`
+	Negated   bool       `mapstructure:"negated" json:"negated"`
+	Comment   string     `mapstructure:"comment" json:"comment"`
+	CreatedAt *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // entriesById is a sortable list of ACL entries.
@@ -44,7 +45,9 @@ type ListACLEntriesInput struct {
 	Sort      string
 }
 
-// ListACLEntries return a list of entries for an ACL
+// ListACLEntries returns the entries of an ACL, following the Link header
+// through every page of results. Use NewListACLEntriesPaginator to fetch one
+// page at a time.
 func (c *Client) ListACLEntries(i *ListACLEntriesInput) ([]*ACLEntry, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
@@ -55,14 +58,15 @@ func (c *Client) ListACLEntries(i *ListACLEntriesInput) ([]*ACLEntry, error) {
 	}
 
 	path := fmt.Sprintf("/service/%s/acl/%s/entries", i.ServiceID, i.ACLID)
-
-	resp, err := c.Get(path, nil)
-	if err != nil {
-		return nil, err
-	}
-
 	var es []*ACLEntry
-	if err := decodeBodyMap(resp.Body, &es); err != nil {
+	if err := c.getAllPages(path, func(body io.ReadCloser) error {
+		var page []*ACLEntry
+		if err := decodeBodyMap(body, &page); err != nil {
+			return err
+		}
+		es = append(es, page...)
+		return nil
+	}); err != nil {
 		return nil, err
 	}
 
@@ -290,7 +294,7 @@ func (c *Client) DeleteACLEntry(i *DeleteACLEntryInput) error {
 	}
 
 	if !r.Ok() {
-		return fmt.Errorf("not ok")
+		return ErrNotOK
 	}
 
 	return nil
@@ -340,13 +344,20 @@ func (c *Client) UpdateACLEntry(i *UpdateACLEntryInput) (*ACLEntry, error) {
 	return e, nil
 }
 
+// BatchModifyACLEntriesInput is the input parameter to the
+// BatchModifyACLEntries function.
 type BatchModifyACLEntriesInput struct {
+	// Required fields
 	ServiceID string `json:"-"`
 	ACLID     string `json:"-"`
 
+	// Entries is the list of operations to apply, at most
+	// BatchModifyMaximumOperations per request.
 	Entries []*BatchACLEntry `json:"entries"`
 }
 
+// BatchACLEntry is a single create, update or delete operation within a
+// BatchModifyACLEntries request.
 type BatchACLEntry struct {
 	Operation BatchOperation `json:"op"`
 	ID        *string        `json:"id,omitempty"`
@@ -356,6 +367,9 @@ type BatchACLEntry struct {
 	Comment   *string        `json:"comment,omitempty"`
 }
 
+// BatchModifyACLEntries creates, updates and deletes entries of an ACL in a
+// single request. It returns ErrMaxExceededEntries when more than
+// BatchModifyMaximumOperations entries are given.
 func (c *Client) BatchModifyACLEntries(i *BatchModifyACLEntriesInput) error {
 	if i.ServiceID == "" {
 		return ErrMissingServiceID
@@ -375,10 +389,13 @@ func (c *Client) BatchModifyACLEntries(i *BatchModifyACLEntriesInput) error {
 		return err
 	}
 
-	var batchModifyResult map[string]string
-	if err := decodeBodyMap(resp.Body, &batchModifyResult); err != nil {
+	var r *statusResp
+	if err := decodeBodyMap(resp.Body, &r); err != nil {
 		return err
 	}
+	if !r.Ok() {
+		return ErrNotOK
+	}
 
 	return nil
 }
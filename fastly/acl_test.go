@@ -1,6 +1,7 @@
 package fastly
 
 import (
+	"net/http"
 	"testing"
 )
 
@@ -146,6 +147,15 @@ func TestClient_CreateACL_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateACL(&CreateACLInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetACL_validation(t *testing.T) {
@@ -237,3 +247,36 @@ func TestClient_DeleteACL_validation(t *testing.T) {
 		t.Errorf("bad error: %s", err)
 	}
 }
+
+func TestClient_ListServiceACLs(t *testing.T) {
+	t.Parallel()
+
+	versions := `[{"number":1},{"number":2,"active":true},{"number":3}]`
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/service/foo/version":
+			w.Write([]byte(versions))
+		case "/service/foo/version/2/acl":
+			w.Write([]byte(`[{"name":"b"},{"name":"a"}]`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	l, err := c.ListServiceACLs(&ListServiceACLsInput{ServiceID: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(l) != 2 || l[0].Name != "a" || l[0].Name == l[1].Name {
+		t.Errorf("bad acls: %v", l)
+	}
+
+	versions = `[{"number":1},{"number":2}]`
+	if _, err := c.ListServiceACLs(&ListServiceACLsInput{ServiceID: "foo"}); err != ErrNoActiveVersion {
+		t.Errorf("bad error: %v", err)
+	}
+
+	if _, err := testClient.ListServiceACLs(&ListServiceACLsInput{}); err != ErrMissingServiceID {
+		t.Errorf("bad error: %v", err)
+	}
+}
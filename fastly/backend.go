@@ -9,39 +9,39 @@ import (
 
 // Backend represents a backend response from the Fastly API.
 type Backend struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name                string     `mapstructure:"name"`
-	Comment             string     `mapstructure:"comment"`
-	Address             string     `mapstructure:"address"`
-	Port                uint       `mapstructure:"port"`
-	OverrideHost        string     `mapstructure:"override_host"`
-	ConnectTimeout      uint       `mapstructure:"connect_timeout"`
-	MaxConn             uint       `mapstructure:"max_conn"`
-	ErrorThreshold      uint       `mapstructure:"error_threshold"`
-	FirstByteTimeout    uint       `mapstructure:"first_byte_timeout"`
-	BetweenBytesTimeout uint       `mapstructure:"between_bytes_timeout"`
-	AutoLoadbalance     bool       `mapstructure:"auto_loadbalance"`
-	Weight              uint       `mapstructure:"weight"`
-	RequestCondition    string     `mapstructure:"request_condition"`
-	HealthCheck         string     `mapstructure:"healthcheck"`
-	Hostname            string     `mapstructure:"hostname"`
-	Shield              string     `mapstructure:"shield"`
-	UseSSL              bool       `mapstructure:"use_ssl"`
-	SSLCheckCert        bool       `mapstructure:"ssl_check_cert"`
-	SSLCACert           string     `mapstructure:"ssl_ca_cert"`
-	SSLClientCert       string     `mapstructure:"ssl_client_cert"`
-	SSLClientKey        string     `mapstructure:"ssl_client_key"`
-	SSLHostname         string     `mapstructure:"ssl_hostname"`
-	SSLCertHostname     string     `mapstructure:"ssl_cert_hostname"`
-	SSLSNIHostname      string     `mapstructure:"ssl_sni_hostname"`
-	MinTLSVersion       string     `mapstructure:"min_tls_version"`
-	MaxTLSVersion       string     `mapstructure:"max_tls_version"`
-	SSLCiphers          string     `mapstructure:"ssl_ciphers"`
-	CreatedAt           *time.Time `mapstructure:"created_at"`
-	UpdatedAt           *time.Time `mapstructure:"updated_at"`
-	DeletedAt           *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name                string     `mapstructure:"name" json:"name"`
+	Comment             string     `mapstructure:"comment" json:"comment"`
+	Address             string     `mapstructure:"address" json:"address"`
+	Port                uint       `mapstructure:"port" json:"port"`
+	OverrideHost        string     `mapstructure:"override_host" json:"override_host"`
+	ConnectTimeout      uint       `mapstructure:"connect_timeout" json:"connect_timeout"`
+	MaxConn             uint       `mapstructure:"max_conn" json:"max_conn"`
+	ErrorThreshold      uint       `mapstructure:"error_threshold" json:"error_threshold"`
+	FirstByteTimeout    uint       `mapstructure:"first_byte_timeout" json:"first_byte_timeout"`
+	BetweenBytesTimeout uint       `mapstructure:"between_bytes_timeout" json:"between_bytes_timeout"`
+	AutoLoadbalance     bool       `mapstructure:"auto_loadbalance" json:"auto_loadbalance"`
+	Weight              uint       `mapstructure:"weight" json:"weight"`
+	RequestCondition    string     `mapstructure:"request_condition" json:"request_condition"`
+	HealthCheck         string     `mapstructure:"healthcheck" json:"healthcheck"`
+	Hostname            string     `mapstructure:"hostname" json:"hostname"`
+	Shield              string     `mapstructure:"shield" json:"shield"`
+	UseSSL              bool       `mapstructure:"use_ssl" json:"use_ssl"`
+	SSLCheckCert        bool       `mapstructure:"ssl_check_cert" json:"ssl_check_cert"`
+	SSLCACert           string     `mapstructure:"ssl_ca_cert" json:"ssl_ca_cert"`
+	SSLClientCert       string     `mapstructure:"ssl_client_cert" json:"ssl_client_cert"`
+	SSLClientKey        string     `mapstructure:"ssl_client_key" json:"ssl_client_key"`
+	SSLHostname         string     `mapstructure:"ssl_hostname" json:"ssl_hostname"`
+	SSLCertHostname     string     `mapstructure:"ssl_cert_hostname" json:"ssl_cert_hostname"`
+	SSLSNIHostname      string     `mapstructure:"ssl_sni_hostname" json:"ssl_sni_hostname"`
+	MinTLSVersion       TLSVersion `mapstructure:"min_tls_version" json:"min_tls_version"`
+	MaxTLSVersion       TLSVersion `mapstructure:"max_tls_version" json:"max_tls_version"`
+	SSLCiphers          string     `mapstructure:"ssl_ciphers" json:"ssl_ciphers"`
+	CreatedAt           *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt           *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt           *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // backendsByName is a sortable list of backends.
@@ -122,12 +122,15 @@ type CreateBackendInput struct {
 	SSLHostname     string      `url:"ssl_hostname,omitempty"`
 	SSLCertHostname string      `url:"ssl_cert_hostname,omitempty"`
 	SSLSNIHostname  string      `url:"ssl_sni_hostname,omitempty"`
-	MinTLSVersion   string      `url:"min_tls_version,omitempty"`
-	MaxTLSVersion   string      `url:"max_tls_version,omitempty"`
+	MinTLSVersion   TLSVersion  `url:"min_tls_version,omitempty"`
+	MaxTLSVersion   TLSVersion  `url:"max_tls_version,omitempty"`
 	SSLCiphers      string      `url:"ssl_ciphers,omitempty"`
 }
 
-// CreateBackend creates a new Fastly backend.
+// CreateBackend creates a new Fastly backend. Backends of a version that have
+// AutoLoadbalance set and are not in a director share traffic in proportion
+// to their Weight, so setting a Weight without AutoLoadbalance returns
+// ErrWeightWithoutAutoLoadbalance.
 func (c *Client) CreateBackend(i *CreateBackendInput) (*Backend, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
@@ -137,6 +140,18 @@ func (c *Client) CreateBackend(i *CreateBackendInput) (*Backend, error) {
 		return nil, ErrMissingServiceVersion
 	}
 
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	if err := validateTLSVersions(i.MinTLSVersion, i.MaxTLSVersion); err != nil {
+		return nil, err
+	}
+
+	if i.Weight != nil && !i.AutoLoadbalance {
+		return nil, ErrWeightWithoutAutoLoadbalance
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/backend", i.ServiceID, i.ServiceVersion)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
@@ -223,9 +238,9 @@ type UpdateBackendInput struct {
 	SSLHostname         *string      `url:"ssl_hostname,omitempty"`
 	SSLCertHostname     *string      `url:"ssl_cert_hostname,omitempty"`
 	SSLSNIHostname      *string      `url:"ssl_sni_hostname,omitempty"`
-	MinTLSVersion       *string      `url:"min_tls_version,omitempty"`
-	MaxTLSVersion       *string      `url:"max_tls_version,omitempty"`
-	SSLCiphers          string       `url:"ssl_ciphers,omitempty"`
+	MinTLSVersion       *TLSVersion  `url:"min_tls_version,omitempty"`
+	MaxTLSVersion       *TLSVersion  `url:"max_tls_version,omitempty"`
+	SSLCiphers          *string      `url:"ssl_ciphers,omitempty"`
 }
 
 // UpdateBackend updates a specific backend.
@@ -242,6 +257,26 @@ func (c *Client) UpdateBackend(i *UpdateBackendInput) (*Backend, error) {
 		return nil, ErrMissingName
 	}
 
+	if i.MinTLSVersion != nil && !i.MinTLSVersion.IsValid() {
+		return nil, ErrInvalidMinTLSVersion
+	}
+
+	if i.MaxTLSVersion != nil && !i.MaxTLSVersion.IsValid() {
+		return nil, ErrInvalidMaxTLSVersion
+	}
+
+	if i.MinTLSVersion != nil && i.MaxTLSVersion != nil {
+		if err := validateTLSVersions(*i.MinTLSVersion, *i.MaxTLSVersion); err != nil {
+			return nil, err
+		}
+	}
+
+	// The backend's current setting is unknown here, so only reject a weight
+	// sent alongside an explicit request to turn auto-loadbalancing off.
+	if i.Weight != nil && i.AutoLoadbalance != nil && !*i.AutoLoadbalance {
+		return nil, ErrWeightWithoutAutoLoadbalance
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/backend/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
@@ -292,7 +327,138 @@ func (c *Client) DeleteBackend(i *DeleteBackendInput) error {
 		return err
 	}
 	if !r.Ok() {
-		return fmt.Errorf("not ok")
+		return ErrNotOK
 	}
 	return nil
 }
+
+// CopyBackendInput is used as input to the CopyBackend function.
+type CopyBackendInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// From is the configuration version to copy the backend from (required).
+	From int
+
+	// To is the configuration version to copy the backend to (required).
+	To int
+
+	// Name is the name of the backend to copy (required).
+	Name string
+}
+
+// CopyBackend copies the named backend from one version of a service to
+// another, carrying over every writable field, and returns the new backend.
+func (c *Client) CopyBackend(i *CopyBackendInput) (*Backend, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	if i.From == 0 {
+		return nil, ErrMissingFrom
+	}
+
+	if i.To == 0 {
+		return nil, ErrMissingTo
+	}
+
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	b, err := c.GetBackend(&GetBackendInput{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.From,
+		Name:           i.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if c.DryRun {
+		return nil, ErrDryRun
+	}
+
+	ci := backendCreateInput(b)
+	ci.ServiceID = i.ServiceID
+	ci.ServiceVersion = i.To
+	return c.CreateBackend(ci)
+}
+
+// backendCreateInput returns the input that creates a copy of b, without the
+// service ID and version.
+func backendCreateInput(b *Backend) *CreateBackendInput {
+	// The API reports a weight for every backend, but it only applies, and
+	// can only be set, when auto-loadbalancing.
+	var weight *uint
+	if b.AutoLoadbalance {
+		weight = Uint(b.Weight)
+	}
+
+	return &CreateBackendInput{
+		Name:                b.Name,
+		Comment:             b.Comment,
+		Address:             b.Address,
+		Port:                Uint(b.Port),
+		OverrideHost:        b.OverrideHost,
+		ConnectTimeout:      Uint(b.ConnectTimeout),
+		MaxConn:             Uint(b.MaxConn),
+		ErrorThreshold:      Uint(b.ErrorThreshold),
+		FirstByteTimeout:    Uint(b.FirstByteTimeout),
+		BetweenBytesTimeout: Uint(b.BetweenBytesTimeout),
+		AutoLoadbalance:     Compatibool(b.AutoLoadbalance),
+		Weight:              weight,
+		RequestCondition:    b.RequestCondition,
+		HealthCheck:         b.HealthCheck,
+		Shield:              b.Shield,
+		UseSSL:              Compatibool(b.UseSSL),
+		SSLCheckCert:        Compatibool(b.SSLCheckCert),
+		SSLCACert:           b.SSLCACert,
+		SSLClientCert:       b.SSLClientCert,
+		SSLClientKey:        b.SSLClientKey,
+		SSLHostname:         b.SSLHostname,
+		SSLCertHostname:     b.SSLCertHostname,
+		SSLSNIHostname:      b.SSLSNIHostname,
+		MinTLSVersion:       b.MinTLSVersion,
+		MaxTLSVersion:       b.MaxTLSVersion,
+		SSLCiphers:          b.SSLCiphers,
+	}
+}
+
+// BackendsUsingHealthCheckInput is used as input to the
+// BackendsUsingHealthCheck function.
+type BackendsUsingHealthCheckInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// ServiceVersion is the specific configuration version (required).
+	ServiceVersion int
+
+	// HealthCheck is the name of the health check (required).
+	HealthCheck string
+}
+
+// BackendsUsingHealthCheck returns the backends of the configuration version
+// that are probed by the named health check. Fastly does not expose live
+// backend health through the API, so this only reports the configured
+// association, computed from ListBackends.
+func (c *Client) BackendsUsingHealthCheck(i *BackendsUsingHealthCheckInput) ([]*Backend, error) {
+	if i.HealthCheck == "" {
+		return nil, ErrMissingHealthCheck
+	}
+
+	bs, err := c.ListBackends(&ListBackendsInput{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []*Backend
+	for _, b := range bs {
+		if b.HealthCheck == i.HealthCheck {
+			matched = append(matched, b)
+		}
+	}
+	return matched, nil
+}
@@ -1,6 +1,7 @@
 package fastly
 
 import (
+	"net/http"
 	"testing"
 )
 
@@ -116,7 +117,7 @@ func TestClient_Backends(t *testing.T) {
 			Name:           "test-backend",
 			NewName:        String("new-test-backend"),
 			OverrideHost:   String("www.example.com"),
-			SSLCiphers:     "RC4:!COMPLEMENTOFDEFAULT",
+			SSLCiphers:     String("RC4:!COMPLEMENTOFDEFAULT"),
 		})
 	})
 	if err != nil {
@@ -176,6 +177,45 @@ func TestClient_CreateBackend_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateBackend(&CreateBackendInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateBackend(&CreateBackendInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		MaxTLSVersion:  "1.4",
+	})
+	if err != ErrInvalidMaxTLSVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateBackend(&CreateBackendInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		MinTLSVersion:  "1.3",
+		MaxTLSVersion:  "1.2",
+	})
+	if err != ErrInvalidTLSVersionRange {
+		t.Errorf("bad error: %s", err)
+	}
+	_, err = testClient.CreateBackend(&CreateBackendInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Weight:         Uint(50),
+	})
+	if err != ErrWeightWithoutAutoLoadbalance {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetBackend_validation(t *testing.T) {
@@ -230,6 +270,37 @@ func TestClient_UpdateBackend_validation(t *testing.T) {
 	if err != ErrMissingName {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.UpdateBackend(&UpdateBackendInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		MinTLSVersion:  PTLSVersion("TLSv1.2"),
+	})
+	if err != ErrInvalidMinTLSVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateBackend(&UpdateBackendInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		MinTLSVersion:  PTLSVersion(TLSVersion12),
+		MaxTLSVersion:  PTLSVersion(TLSVersion10),
+	})
+	if err != ErrInvalidTLSVersionRange {
+		t.Errorf("bad error: %s", err)
+	}
+	_, err = testClient.UpdateBackend(&UpdateBackendInput{
+		ServiceID:       "foo",
+		ServiceVersion:  1,
+		Name:            "test",
+		AutoLoadbalance: CBool(false),
+		Weight:          Uint(50),
+	})
+	if err != ErrWeightWithoutAutoLoadbalance {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_DeleteBackend_validation(t *testing.T) {
@@ -258,3 +329,101 @@ func TestClient_DeleteBackend_validation(t *testing.T) {
 		t.Errorf("bad error: %s", err)
 	}
 }
+
+func TestClient_CopyBackend(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/service/foo/version/1/backend/origin":
+			w.Write([]byte(`{"service_id":"foo","version":1,"name":"origin","address":"example.com","port":443,"use_ssl":true,"ssl_check_cert":false,"created_at":"2021-01-01T00:00:00Z"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/service/foo/version/2/backend":
+			if err := r.ParseForm(); err != nil {
+				t.Error(err)
+				return
+			}
+			for k, want := range map[string]string{
+				"name":           "origin",
+				"address":        "example.com",
+				"port":           "443",
+				"use_ssl":        "1",
+				"ssl_check_cert": "0",
+			} {
+				if got := r.PostForm.Get(k); got != want {
+					t.Errorf("bad %s: %q, want %q", k, got, want)
+				}
+			}
+			if r.PostForm.Get("created_at") != "" {
+				t.Error("read-only created_at was sent")
+			}
+			w.Write([]byte(`{"service_id":"foo","version":2,"name":"origin","address":"example.com","port":443}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	b, err := c.CopyBackend(&CopyBackendInput{
+		ServiceID: "foo",
+		From:      1,
+		To:        2,
+		Name:      "origin",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.ServiceVersion != 2 || b.Name != "origin" {
+		t.Errorf("bad backend: %+v", b)
+	}
+}
+
+func TestClient_CopyBackend_validation(t *testing.T) {
+	var err error
+	_, err = testClient.CopyBackend(&CopyBackendInput{
+		ServiceID: "foo",
+		To:        2,
+		Name:      "origin",
+	})
+	if err != ErrMissingFrom {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CopyBackend(&CopyBackendInput{
+		ServiceID: "foo",
+		From:      1,
+		Name:      "origin",
+	})
+	if err != ErrMissingTo {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_BackendsUsingHealthCheck(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/service/foo/version/1/backend" {
+			t.Errorf("bad path: %q", r.URL.Path)
+		}
+		w.Write([]byte(`[{"name":"b","healthcheck":"probe"},{"name":"a","healthcheck":"probe"},{"name":"c","healthcheck":"other"},{"name":"d"}]`))
+	})
+
+	bs, err := c.BackendsUsingHealthCheck(&BackendsUsingHealthCheckInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		HealthCheck:    "probe",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bs) != 2 || bs[0].Name != "a" || bs[1].Name != "b" {
+		t.Errorf("bad backends: %+v", bs)
+	}
+
+	_, err = c.BackendsUsingHealthCheck(&BackendsUsingHealthCheckInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+	})
+	if err != ErrMissingHealthCheck {
+		t.Errorf("bad error: %s", err)
+	}
+}
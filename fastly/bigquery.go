@@ -9,23 +9,23 @@ import (
 
 // BigQuery represents a BigQuery response from the Fastly API.
 type BigQuery struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name              string     `mapstructure:"name"`
-	Format            string     `mapstructure:"format"`
-	User              string     `mapstructure:"user"`
-	ProjectID         string     `mapstructure:"project_id"`
-	Dataset           string     `mapstructure:"dataset"`
-	Table             string     `mapstructure:"table"`
-	Template          string     `mapstructure:"template_suffix"`
-	SecretKey         string     `mapstructure:"secret_key"`
-	ResponseCondition string     `mapstructure:"response_condition"`
-	Placement         string     `mapstructure:"placement"`
-	FormatVersion     uint       `mapstructure:"format_version"`
-	CreatedAt         *time.Time `mapstructure:"created_at"`
-	UpdatedAt         *time.Time `mapstructure:"updated_at"`
-	DeletedAt         *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name              string     `mapstructure:"name" json:"name"`
+	Format            string     `mapstructure:"format" json:"format"`
+	User              string     `mapstructure:"user" json:"user"`
+	ProjectID         string     `mapstructure:"project_id" json:"project_id"`
+	Dataset           string     `mapstructure:"dataset" json:"dataset"`
+	Table             string     `mapstructure:"table" json:"table"`
+	Template          string     `mapstructure:"template_suffix" json:"template_suffix"`
+	SecretKey         string     `mapstructure:"secret_key" json:"secret_key"`
+	ResponseCondition string     `mapstructure:"response_condition" json:"response_condition"`
+	Placement         string     `mapstructure:"placement" json:"placement"`
+	FormatVersion     uint       `mapstructure:"format_version" json:"format_version"`
+	CreatedAt         *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // bigQueriesByName is a sortable list of BigQueries.
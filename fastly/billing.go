@@ -8,56 +8,103 @@ import (
 // Billing is the top-level representation of a billing response from the Fastly
 // API.
 type Billing struct {
-	InvoiceID string         `mapstructure:"invoice_id"`
-	StartTime *time.Time     `mapstructure:"start_time"`
-	EndTime   *time.Time     `mapstructure:"end_time"`
-	Status    *BillingStatus `mapstructure:"status"`
-	Total     *BillingTotal  `mapstructure:"total"`
+	InvoiceID string         `mapstructure:"invoice_id" json:"invoice_id"`
+	StartTime *time.Time     `mapstructure:"start_time" json:"start_time,omitempty"`
+	EndTime   *time.Time     `mapstructure:"end_time" json:"end_time,omitempty"`
+	Status    *BillingStatus `mapstructure:"status" json:"status"`
+	Total     *BillingTotal  `mapstructure:"total" json:"total"`
+
+	// Regions holds the usage and cost for each billing region, keyed by
+	// region name.
+	Regions map[string]*BillingRegion `mapstructure:"regions" json:"regions"`
+
+	// LineItems are the individual charges on the invoice.
+	LineItems []*BillingLineItem `mapstructure:"line_items" json:"line_items"`
 }
 
 // BillingStatus is a representation of the status of the bill from the Fastly
 // API.
 type BillingStatus struct {
-	InvoiceID string     `mapstructure:"invoice_id"`
-	Status    string     `mapstructure:"status"`
-	SentAt    *time.Time `mapstructure:"sent_at"`
+	InvoiceID string     `mapstructure:"invoice_id" json:"invoice_id"`
+	Status    string     `mapstructure:"status" json:"status"`
+	SentAt    *time.Time `mapstructure:"sent_at" json:"sent_at,omitempty"`
 }
 
 // BillingTotal is a representation of the status of the usage for this bill
 // from the Fastly API.
 type BillingTotal struct {
-	PlanName           string          `mapstructure:"plan_name"`
-	PlanCode           string          `mapstructure:"plan_code"`
-	PlanMinimum        string          `mapstructure:"plan_minimum"`
-	Bandwidth          float64         `mapstructure:"bandwidth"`
-	BandwidthCost      float64         `mapstructure:"bandwidth_cost"`
-	Requests           uint64          `mapstructure:"requests"`
-	RequestsCost       float64         `mapstructure:"requests_cost"`
-	IncurredCost       float64         `mapstructure:"incurred_cost"`
-	Overage            float64         `mapstructure:"overage"`
-	Extras             []*BillingExtra `mapstructure:"extras"`
-	ExtrasCost         float64         `mapstructure:"extras_cost"`
-	CostBeforeDiscount float64         `mapstructure:"cost_before_discount"`
-	Discount           float64         `mapstructure:"discount"`
-	Cost               float64         `mapstructure:"cost"`
-	Terms              string          `mapstructure:"terms"`
+	PlanName           string          `mapstructure:"plan_name" json:"plan_name"`
+	PlanCode           string          `mapstructure:"plan_code" json:"plan_code"`
+	PlanMinimum        string          `mapstructure:"plan_minimum" json:"plan_minimum"`
+	Bandwidth          float64         `mapstructure:"bandwidth" json:"bandwidth"`
+	BandwidthCost      float64         `mapstructure:"bandwidth_cost" json:"bandwidth_cost"`
+	Requests           uint64          `mapstructure:"requests" json:"requests"`
+	RequestsCost       float64         `mapstructure:"requests_cost" json:"requests_cost"`
+	IncurredCost       float64         `mapstructure:"incurred_cost" json:"incurred_cost"`
+	Overage            float64         `mapstructure:"overage" json:"overage"`
+	Extras             []*BillingExtra `mapstructure:"extras" json:"extras"`
+	ExtrasCost         float64         `mapstructure:"extras_cost" json:"extras_cost"`
+	CostBeforeDiscount float64         `mapstructure:"cost_before_discount" json:"cost_before_discount"`
+	Discount           float64         `mapstructure:"discount" json:"discount"`
+	Cost               float64         `mapstructure:"cost" json:"cost"`
+	Terms              string          `mapstructure:"terms" json:"terms"`
 }
 
 // BillingExtra is a representation of extras (such as SSL addons) from the
 // Fastly API.
 type BillingExtra struct {
-	Name      string  `mapstructure:"name"`
-	Setup     float64 `mapstructure:"setup"`
-	Recurring float64 `mapstructure:"recurring"`
+	Name      string  `mapstructure:"name" json:"name"`
+	Setup     float64 `mapstructure:"setup" json:"setup"`
+	Recurring float64 `mapstructure:"recurring" json:"recurring"`
+}
+
+// BillingRegion is a representation of the usage and cost of a single billing
+// region from the Fastly API.
+type BillingRegion struct {
+	Bandwidth *BillingRegionUsage `mapstructure:"bandwidth" json:"bandwidth"`
+	Requests  *BillingRegionUsage `mapstructure:"requests" json:"requests"`
+	Cost      float64             `mapstructure:"cost" json:"cost"`
+}
+
+// BillingRegionUsage is a representation of the cost of one kind of usage,
+// bandwidth or requests, in a billing region from the Fastly API, broken down
+// by pricing tier.
+type BillingRegionUsage struct {
+	Total float64        `mapstructure:"total" json:"total"`
+	Tiers []*BillingTier `mapstructure:"tiers" json:"tiers"`
+}
+
+// BillingTier is a representation of the usage and cost within a single
+// pricing tier from the Fastly API.
+type BillingTier struct {
+	Name  string  `mapstructure:"name" json:"name"`
+	Units float64 `mapstructure:"units" json:"units"`
+	Price float64 `mapstructure:"price" json:"price"`
+	Total float64 `mapstructure:"total" json:"total"`
 }
 
+// BillingLineItem is a representation of a single charge on a bill from the
+// Fastly API.
+type BillingLineItem struct {
+	Description string  `mapstructure:"description" json:"description"`
+	Region      string  `mapstructure:"region" json:"region"`
+	Units       float64 `mapstructure:"units" json:"units"`
+	Rate        float64 `mapstructure:"rate" json:"rate"`
+	Amount      float64 `mapstructure:"amount" json:"amount"`
+}
+
+// firstBillingYear is the earliest year for which Fastly issued invoices.
+const firstBillingYear = 2011
+
 // GetBillingInput is used as input to the GetBilling function.
 type GetBillingInput struct {
 	Year  uint16
 	Month uint8
 }
 
-// GetBilling returns the billing information for the current account.
+// GetBilling returns the billing information for the current account. Year
+// must be between 2011 and the current year in UTC, and Month between 1 and
+// 12.
 func (c *Client) GetBilling(i *GetBillingInput) (*Billing, error) {
 	if i.Year == 0 {
 		return nil, ErrMissingYear
@@ -67,6 +114,14 @@ func (c *Client) GetBilling(i *GetBillingInput) (*Billing, error) {
 		return nil, ErrMissingMonth
 	}
 
+	if i.Year < firstBillingYear || int(i.Year) > time.Now().UTC().Year() {
+		return nil, ErrInvalidYear
+	}
+
+	if i.Month > 12 {
+		return nil, ErrInvalidMonth
+	}
+
 	path := fmt.Sprintf("/billing/year/%d/month/%02d", i.Year, i.Month)
 	resp, err := c.Get(path, nil)
 	if err != nil {
@@ -0,0 +1,70 @@
+package fastly
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestClient_GetBilling(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/billing/year/2021/month/06" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(`{"invoice_id":"2C6FAD9FCD0A3D7F","start_time":"2021-06-01T00:00:00Z","end_time":"2021-06-30T23:59:59Z",` +
+			`"status":{"invoice_id":"2C6FAD9FCD0A3D7F","status":"Pending","sent_at":null},` +
+			`"total":{"plan_name":"Developer","plan_code":"developer","plan_minimum":"50.00","bandwidth":0.5,"bandwidth_cost":0.06,` +
+			`"requests":120000,"requests_cost":0.09,"incurred_cost":0.15,"overage":0,"extras":[],"extras_cost":0,` +
+			`"cost_before_discount":50,"discount":0,"cost":50,"terms":"Net 30"},` +
+			`"regions":{"usa":{"bandwidth":{"total":0.06,"tiers":[{"name":"First 10 TB","units":0.5,"price":0.12,"total":0.06},` +
+			`{"name":"Next 40 TB","units":0,"price":0.08,"total":0}]},` +
+			`"requests":{"total":0.09,"tiers":[{"name":"Per 10k Requests","units":12,"price":0.0075,"total":0.09}]},"cost":0.15},` +
+			`"europe":{"bandwidth":{"total":0,"tiers":[{"name":"First 10 TB","units":0,"price":0.12,"total":0}]},` +
+			`"requests":{"total":0,"tiers":[{"name":"Per 10k Requests","units":0,"price":0.0075,"total":0}]},"cost":0}}}`))
+	})
+
+	b, err := c.GetBilling(&GetBillingInput{Year: 2021, Month: 6})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Total == nil || b.Total.Requests != 120000 {
+		t.Errorf("bad total: %+v", b.Total)
+	}
+	usa := b.Regions["usa"]
+	if usa == nil || usa.Cost != 0.15 {
+		t.Fatalf("bad regions: %+v", b.Regions)
+	}
+	if usa.Bandwidth == nil || usa.Bandwidth.Total != 0.06 || len(usa.Bandwidth.Tiers) != 2 {
+		t.Errorf("bad bandwidth: %+v", usa.Bandwidth)
+	}
+	if tier := usa.Bandwidth.Tiers[0]; tier.Name != "First 10 TB" || tier.Units != 0.5 || tier.Price != 0.12 {
+		t.Errorf("bad bandwidth tier: %+v", tier)
+	}
+	if usa.Requests == nil || usa.Requests.Total != 0.09 || len(usa.Requests.Tiers) != 1 {
+		t.Errorf("bad requests: %+v", usa.Requests)
+	}
+}
+
+func TestClient_GetBilling_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetBilling(&GetBillingInput{Month: 1})
+	if err != ErrMissingYear {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetBilling(&GetBillingInput{Year: 2021})
+	if err != ErrMissingMonth {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetBilling(&GetBillingInput{Year: 1999, Month: 1})
+	if err != ErrInvalidYear {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetBilling(&GetBillingInput{Year: 2021, Month: 13})
+	if err != ErrInvalidMonth {
+		t.Errorf("bad error: %s", err)
+	}
+}
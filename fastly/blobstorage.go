@@ -9,28 +9,28 @@ import (
 
 // BlobStorage represents a blob storage response from the Fastly API.
 type BlobStorage struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name              string     `mapstructure:"name"`
-	Path              string     `mapstructure:"path"`
-	AccountName       string     `mapstructure:"account_name"`
-	Container         string     `mapstructure:"container"`
-	SASToken          string     `mapstructure:"sas_token"`
-	Period            uint       `mapstructure:"period"`
-	TimestampFormat   string     `mapstructure:"timestamp_format"`
-	CompressionCodec  string     `mapstructure:"compression_codec"`
-	GzipLevel         uint       `mapstructure:"gzip_level"`
-	PublicKey         string     `mapstructure:"public_key"`
-	Format            string     `mapstructure:"format"`
-	FormatVersion     uint       `mapstructure:"format_version"`
-	MessageType       string     `mapstructure:"message_type"`
-	Placement         string     `mapstructure:"placement"`
-	ResponseCondition string     `mapstructure:"response_condition"`
-	FileMaxBytes      uint       `mapstructure:"file_max_bytes"`
-	CreatedAt         *time.Time `mapstructure:"created_at"`
-	UpdatedAt         *time.Time `mapstructure:"updated_at"`
-	DeletedAt         *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name              string     `mapstructure:"name" json:"name"`
+	Path              string     `mapstructure:"path" json:"path"`
+	AccountName       string     `mapstructure:"account_name" json:"account_name"`
+	Container         string     `mapstructure:"container" json:"container"`
+	SASToken          string     `mapstructure:"sas_token" json:"sas_token"`
+	Period            uint       `mapstructure:"period" json:"period"`
+	TimestampFormat   string     `mapstructure:"timestamp_format" json:"timestamp_format"`
+	CompressionCodec  string     `mapstructure:"compression_codec" json:"compression_codec"`
+	GzipLevel         uint       `mapstructure:"gzip_level" json:"gzip_level"`
+	PublicKey         string     `mapstructure:"public_key" json:"public_key"`
+	Format            string     `mapstructure:"format" json:"format"`
+	FormatVersion     uint       `mapstructure:"format_version" json:"format_version"`
+	MessageType       string     `mapstructure:"message_type" json:"message_type"`
+	Placement         string     `mapstructure:"placement" json:"placement"`
+	ResponseCondition string     `mapstructure:"response_condition" json:"response_condition"`
+	FileMaxBytes      uint       `mapstructure:"file_max_bytes" json:"file_max_bytes"`
+	CreatedAt         *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // blobStorageByName is a sortable list of blob storages.
@@ -104,12 +104,12 @@ type CreateBlobStorageInput struct {
 
 // CreateBlobStorage creates a new Fastly blob storage.
 func (c *Client) CreateBlobStorage(i *CreateBlobStorageInput) (*BlobStorage, error) {
-	if i.ServiceID == "" {
-		return nil, ErrMissingServiceID
+	if err := validateServiceVersionName(i.ServiceID, i.ServiceVersion, i.Name); err != nil {
+		return nil, err
 	}
 
-	if i.ServiceVersion == 0 {
-		return nil, ErrMissingServiceVersion
+	if i.GzipLevel != 0 && i.CompressionCodec != "" {
+		return nil, ErrGzipLevelWithCompressionCodec
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/logging/azureblob", i.ServiceID, i.ServiceVersion)
@@ -207,6 +207,10 @@ func (c *Client) UpdateBlobStorage(i *UpdateBlobStorageInput) (*BlobStorage, err
 		return nil, ErrMissingName
 	}
 
+	if i.GzipLevel != nil && *i.GzipLevel != 0 && i.CompressionCodec != nil && *i.CompressionCodec != "" {
+		return nil, ErrGzipLevelWithCompressionCodec
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/logging/azureblob/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
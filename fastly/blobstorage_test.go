@@ -397,6 +397,26 @@ func TestClient_CreateBlobStorage_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateBlobStorage(&CreateBlobStorageInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateBlobStorage(&CreateBlobStorageInput{
+		ServiceID:        "foo",
+		ServiceVersion:   1,
+		Name:             "test",
+		CompressionCodec: "snappy",
+		GzipLevel:        8,
+	})
+	if err != ErrGzipLevelWithCompressionCodec {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetBlobStorage_validation(t *testing.T) {
@@ -451,6 +471,17 @@ func TestClient_UpdateBlobStorage_validation(t *testing.T) {
 	if err != ErrMissingName {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.UpdateBlobStorage(&UpdateBlobStorageInput{
+		ServiceID:        "foo",
+		ServiceVersion:   1,
+		Name:             "test",
+		CompressionCodec: String("zstd"),
+		GzipLevel:        Uint(9),
+	})
+	if err != ErrGzipLevelWithCompressionCodec {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_DeleteBlobStorage_validation(t *testing.T) {
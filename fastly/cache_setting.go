@@ -21,19 +21,35 @@ const (
 // CacheSettingAction is the type of cache action.
 type CacheSettingAction string
 
+// IsValid reports whether the action is one supported by the Fastly API. An
+// empty action is valid and is treated by Fastly as "deliver".
+func (a CacheSettingAction) IsValid() bool {
+	switch a {
+	case "", CacheSettingActionCache, CacheSettingActionPass, CacheSettingActionRestart:
+		return true
+	default:
+		return false
+	}
+}
+
+// PCacheSettingAction returns pointer to CacheSettingAction.
+func PCacheSettingAction(a CacheSettingAction) *CacheSettingAction {
+	return &a
+}
+
 // CacheSetting represents a response from Fastly's API for cache settings.
 type CacheSetting struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name           string             `mapstructure:"name"`
-	Action         CacheSettingAction `mapstructure:"action"`
-	TTL            uint               `mapstructure:"ttl"`
-	StaleTTL       uint               `mapstructure:"stale_ttl"`
-	CacheCondition string             `mapstructure:"cache_condition"`
-	CreatedAt      *time.Time         `mapstructure:"created_at"`
-	UpdatedAt      *time.Time         `mapstructure:"updated_at"`
-	DeletedAt      *time.Time         `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name           string             `mapstructure:"name" json:"name"`
+	Action         CacheSettingAction `mapstructure:"action" json:"action"`
+	TTL            uint               `mapstructure:"ttl" json:"ttl"`
+	StaleTTL       uint               `mapstructure:"stale_ttl" json:"stale_ttl"`
+	CacheCondition string             `mapstructure:"cache_condition" json:"cache_condition"`
+	CreatedAt      *time.Time         `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt      *time.Time         `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt      *time.Time         `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // cacheSettingsByName is a sortable list of cache settings.
@@ -105,6 +121,16 @@ func (c *Client) CreateCacheSetting(i *CreateCacheSettingInput) (*CacheSetting,
 		return nil, ErrMissingServiceVersion
 	}
 
+	if !i.Action.IsValid() {
+		return nil, ErrInvalidCacheSettingAction
+	}
+
+	if err := c.checkConditions(i.ServiceID, i.ServiceVersion,
+		conditionRef{"CacheCondition", i.CacheCondition, ConditionTypeCache},
+	); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/cache_settings", i.ServiceID, i.ServiceVersion)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
@@ -169,11 +195,11 @@ type UpdateCacheSettingInput struct {
 	// Name is the name of the cache setting to update.
 	Name string
 
-	NewName        *string            `url:"name,omitempty"`
-	Action         CacheSettingAction `url:"action,omitempty"`
-	TTL            *uint              `url:"ttl,omitempty"`
-	StaleTTL       *uint              `url:"stale_ttl,omitempty"`
-	CacheCondition *string            `url:"cache_condition,omitempty"`
+	NewName        *string             `url:"name,omitempty"`
+	Action         *CacheSettingAction `url:"action,omitempty"`
+	TTL            *uint               `url:"ttl,omitempty"`
+	StaleTTL       *uint               `url:"stale_ttl,omitempty"`
+	CacheCondition *string             `url:"cache_condition,omitempty"`
 }
 
 // UpdateCacheSetting updates a specific cache setting.
@@ -190,6 +216,16 @@ func (c *Client) UpdateCacheSetting(i *UpdateCacheSettingInput) (*CacheSetting,
 		return nil, ErrMissingName
 	}
 
+	if i.Action != nil && !i.Action.IsValid() {
+		return nil, ErrInvalidCacheSettingAction
+	}
+
+	if err := c.checkConditions(i.ServiceID, i.ServiceVersion,
+		optionalConditionRef("CacheCondition", i.CacheCondition, ConditionTypeCache),
+	); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/cache_settings/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
@@ -163,6 +163,15 @@ func TestClient_CreateCacheSetting_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateCacheSetting(&CreateCacheSettingInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Action:         CacheSettingAction("bogus"),
+	})
+	if err != ErrInvalidCacheSettingAction {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetCacheSetting_validation(t *testing.T) {
@@ -217,6 +226,16 @@ func TestClient_UpdateCacheSetting_validation(t *testing.T) {
 	if err != ErrMissingName {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.UpdateCacheSetting(&UpdateCacheSettingInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Action:         PCacheSettingAction("bogus"),
+	})
+	if err != ErrInvalidCacheSettingAction {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_DeleteCacheSetting_validation(t *testing.T) {
@@ -2,22 +2,29 @@ package fastly
 
 import (
 	"bytes"
+	"compress/gzip"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"mime/multipart"
 	"net/http"
 	"net/url"
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/google/go-querystring/query"
 	"github.com/google/jsonapi"
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/mitchellh/mapstructure"
+	"golang.org/x/time/rate"
 )
 
 // APIKeyEnvVar is the name of the environment variable where the Fastly API
@@ -27,6 +34,14 @@ const APIKeyEnvVar = "FASTLY_API_KEY"
 // APIKeyHeader is the name of the header that contains the Fastly API key.
 const APIKeyHeader = "Fastly-Key"
 
+// RateLimitRemainingHeader is the name of the header that reports how many
+// modifying requests remain in the current rate limit window.
+const RateLimitRemainingHeader = "Fastly-RateLimit-Remaining"
+
+// RateLimitResetHeader is the name of the header that reports, as a Unix
+// timestamp, when the current rate limit window resets.
+const RateLimitResetHeader = "Fastly-RateLimit-Reset"
+
 // EndpointEnvVar is the name of an environment variable that can be used
 // to change the URL of API requests.
 const EndpointEnvVar = "FASTLY_API_URL"
@@ -42,13 +57,18 @@ const RealtimeStatsEndpointEnvVar = "FASTLY_RTS_URL"
 // DefaultRealtimeStatsEndpoint is the realtime stats endpoint for Fastly.
 const DefaultRealtimeStatsEndpoint = "https://rt.fastly.com"
 
+// DefaultHTTPTimeout is the overall timeout of each request made by the
+// default HTTP client.
+const DefaultHTTPTimeout = 60 * time.Second
+
 // ProjectURL is the url for this library.
 var ProjectURL = "github.com/fastly/go-fastly"
 
 // ProjectVersion is the version of this library.
 var ProjectVersion = "5.2.0"
 
-// UserAgent is the user agent for this particular client.
+// UserAgent is the default user agent sent by clients that do not set
+// Client.UserAgent.
 var UserAgent = fmt.Sprintf("FastlyGo/%s (+%s; %s)",
 	ProjectVersion, ProjectURL, runtime.Version())
 
@@ -57,14 +77,82 @@ type Client struct {
 	// Address is the address of Fastly's API endpoint.
 	Address string
 
+	// UserAgent is sent in the User-Agent header of every request, allowing
+	// tools built on this library to identify themselves. If empty, the
+	// package-level UserAgent is used.
+	UserAgent string
+
 	// HTTPClient is the HTTP client to use. If one is not provided, a default
-	// client will be used.
+	// client will be used which reuses connections and times out requests after
+	// DefaultHTTPTimeout.
 	HTTPClient *http.Client
 
+	// RetryConfig enables retrying of idempotent requests that fail with a
+	// transient error. If one is not provided, requests are not retried.
+	RetryConfig *RetryConfig
+
+	// DryRun, when true, makes the client record each request, available
+	// through RecordedRequests, instead of sending it. Nothing is sent over the
+	// network and calls return zero-value results. Operations that need the
+	// result of one request to make the next record the first request and
+	// return ErrDryRun.
+	DryRun bool
+
+	// configLock guards the settings changed by the Set* and Enable* methods,
+	// which may be called while requests are in flight: limiter,
+	// requestHook, responseHook, redactor, responseCache, serviceTypeChecks,
+	// conditionChecks and wafReferenceChecks.
+	configLock sync.RWMutex
+
+	// limiter throttles outgoing requests when set with SetRateLimit.
+	limiter *rate.Limiter
+
+	// dryRunLock guards recordedRequests, the requests captured in DryRun
+	// mode.
+	dryRunLock       sync.Mutex
+	recordedRequests []RecordedRequest
+
 	// updateLock forces serialization of calls that modify a service.
 	// Concurrent modifications have undefined semantics.
 	updateLock sync.Mutex
 
+	// rateLimitLock guards the rate limit values reported by the API.
+	rateLimitLock sync.RWMutex
+
+	// rateLimitRemaining and rateLimitReset are the most recent values of the
+	// rate limit headers returned by the API.
+	rateLimitRemaining int
+	rateLimitReset     time.Time
+
+	// requestHook and responseHook are the optional hooks registered with
+	// SetRequestHook and SetResponseHook.
+	requestHook  func(*http.Request)
+	responseHook func(*http.Response, time.Duration)
+
+	// redactor holds the fields set with SetRedactedFields.
+	redactor *redactor
+
+	// responseCache holds ETag-validated GET responses when enabled with
+	// EnableResponseCache.
+	responseCache *responseCache
+
+	// serviceTypeChecks enables the service type checks turned on by
+	// EnableServiceTypeChecks.
+	serviceTypeChecks bool
+
+	// conditionChecks enables the condition reference checks turned on by
+	// EnableConditionChecks.
+	conditionChecks bool
+
+	// wafReferenceChecks enables the WAF reference checks turned on by
+	// EnableWAFReferenceChecks.
+	wafReferenceChecks bool
+
+	// serviceTypesLock guards serviceTypes, which caches the type of each
+	// service looked up by ServiceType.
+	serviceTypesLock sync.Mutex
+	serviceTypes     map[string]string
+
 	// apiKey is the Fastly API key to authenticate requests.
 	apiKey string
 
@@ -103,14 +191,24 @@ func NewClient(key string) (*Client, error) {
 }
 
 // NewClientForEndpoint creates a new API client with the given key and API
-// endpoint. Because Fastly allows some requests without an API key, this
-// function will not error if the API token is not supplied. Attempts to make a
-// request that requires an API key will return a 403 response.
+// endpoint, which must be an absolute URL such as a local mock server.
+// Because Fastly allows some requests without an API key, this function will
+// not error if the API token is not supplied. Attempts to make a request that
+// requires an API key will return a 403 response.
 func NewClientForEndpoint(key string, endpoint string) (*Client, error) {
 	client := &Client{apiKey: key, Address: endpoint}
 	return client.init()
 }
 
+// NewClientWithHTTPClient creates a new API client with the given key and API
+// endpoint that sends requests through httpClient. Use it to tune the
+// transport, for example connection pooling, proxies or a custom CA. A nil
+// httpClient selects the default client.
+func NewClientWithHTTPClient(key, endpoint string, httpClient *http.Client) (*Client, error) {
+	client := &Client{apiKey: key, Address: endpoint, HTTPClient: httpClient}
+	return client.init()
+}
+
 // NewRealtimeStatsClient instantiates a new Fastly API client for the realtime stats.
 // This function requires the environment variable `FASTLY_API_KEY` is set and contains
 // a valid API key to authenticate with Fastly.
@@ -144,15 +242,27 @@ func (c *Client) init() (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
+	if !u.IsAbs() || u.Host == "" {
+		return nil, fmt.Errorf("invalid endpoint %q: must be an absolute URL", c.Address)
+	}
 	c.url = u
 
 	if c.HTTPClient == nil {
-		c.HTTPClient = cleanhttp.DefaultClient()
+		c.HTTPClient = cleanhttp.DefaultPooledClient()
+		c.HTTPClient.Timeout = DefaultHTTPTimeout
 	}
 
 	return c, nil
 }
 
+// userAgent returns the User-Agent header value for requests made by c.
+func (c *Client) userAgent() string {
+	if c.UserAgent != "" {
+		return c.UserAgent
+	}
+	return UserAgent
+}
+
 // Get issues an HTTP GET request.
 func (c *Client) Get(p string, ro *RequestOptions) (*http.Response, error) {
 	if ro == nil {
@@ -201,6 +311,12 @@ func (c *Client) PostForm(p string, i interface{}, ro *RequestOptions) (*http.Re
 	return c.RequestForm("POST", p, i, ro)
 }
 
+// PostMultipart issues an HTTP POST request with the given fields and files
+// encoded as multipart/form-data.
+func (c *Client) PostMultipart(p string, fields map[string]string, files map[string]FormFile, ro *RequestOptions) (*http.Response, error) {
+	return c.RequestMultipart("POST", p, fields, files, ro)
+}
+
 // PostJSON issues an HTTP POST request with the given interface json-encoded.
 func (c *Client) PostJSON(p string, i interface{}, ro *RequestOptions) (*http.Response, error) {
 	return c.RequestJSON("POST", p, i, ro)
@@ -231,6 +347,18 @@ func (c *Client) PutFormFile(urlPath string, filePath string, fieldName string,
 	return c.RequestFormFile("PUT", urlPath, filePath, fieldName, ro)
 }
 
+// PutFormFileFromReader issues an HTTP PUT request (multipart/form-encoded) to
+// put the contents of r to an endpoint as a file named fileName.
+func (c *Client) PutFormFileFromReader(urlPath string, fileName string, r io.Reader, fieldName string, ro *RequestOptions) (*http.Response, error) {
+	return c.RequestFormFileFromReader("PUT", urlPath, fileName, r, fieldName, ro)
+}
+
+// PutMultipart issues an HTTP PUT request with the given fields and files
+// encoded as multipart/form-data.
+func (c *Client) PutMultipart(p string, fields map[string]string, files map[string]FormFile, ro *RequestOptions) (*http.Response, error) {
+	return c.RequestMultipart("PUT", p, fields, files, ro)
+}
+
 // PutJSON issues an HTTP PUT request with the given interface json-encoded.
 func (c *Client) PutJSON(p string, i interface{}, ro *RequestOptions) (*http.Response, error) {
 	return c.RequestJSON("PUT", p, i, ro)
@@ -264,15 +392,21 @@ func (c *Client) Request(verb, p string, ro *RequestOptions) (*http.Response, er
 		return nil, err
 	}
 
+	if c.DryRun {
+		return c.recordDryRun(req)
+	}
+
 	if ro == nil || !ro.Parallel {
 		c.updateLock.Lock()
 		defer c.updateLock.Unlock()
 
 	}
-	resp, err := checkResp(c.HTTPClient.Do(req))
+	resp, err := checkResp(c.recordRateLimit(c.doWithCache(req, func(req *http.Request) (*http.Response, error) {
+		return c.doWithHooks(req, c.doWithRetry)
+	})))
 
 	if err != nil {
-		return resp, err
+		return resp, c.redaction().error(err)
 	}
 
 	return resp, nil
@@ -310,14 +444,20 @@ func (c *Client) RequestFormFile(verb, urlPath string, filePath string, fieldNam
 	}
 	defer file.Close() // #nosec G307
 
+	return c.RequestFormFileFromReader(verb, urlPath, filepath.Base(filePath), file, fieldName, ro)
+}
+
+// RequestFormFileFromReader makes an HTTP request to upload the contents of r
+// to an endpoint as a file named fileName.
+func (c *Client) RequestFormFileFromReader(verb, urlPath string, fileName string, r io.Reader, fieldName string, ro *RequestOptions) (*http.Response, error) {
 	var body bytes.Buffer
 	writer := multipart.NewWriter(&body)
-	part, err := writer.CreateFormFile(fieldName, filepath.Base(filePath))
+	part, err := writer.CreateFormFile(fieldName, fileName)
 	if err != nil {
 		return nil, fmt.Errorf("error creating multipart form: %v", err)
 	}
 
-	_, err = io.Copy(part, file)
+	_, err = io.Copy(part, r)
 	if err != nil {
 		return nil, fmt.Errorf("error copying file to multipart form: %v", err)
 	}
@@ -341,6 +481,74 @@ func (c *Client) RequestFormFile(verb, urlPath string, filePath string, fieldNam
 	return c.Request(verb, urlPath, ro)
 }
 
+// FormFile is a file sent in a multipart/form-data request.
+type FormFile struct {
+	// Name is the file name sent with the contents.
+	Name string
+
+	// Reader supplies the contents of the file.
+	Reader io.Reader
+}
+
+// RequestMultipart makes an HTTP request whose body is a multipart/form-data
+// form holding fields and files, each keyed by form field name. The body is
+// streamed as it is sent rather than built in memory, so large files are
+// never buffered whole; as a consequence the request is not retried.
+func (c *Client) RequestMultipart(verb, p string, fields map[string]string, files map[string]FormFile, ro *RequestOptions) (*http.Response, error) {
+	pr, pw := io.Pipe()
+	defer pr.Close()
+
+	writer := multipart.NewWriter(pw)
+	go func() {
+		pw.CloseWithError(writeMultipart(writer, fields, files))
+	}()
+
+	if ro == nil {
+		ro = new(RequestOptions)
+	}
+	if ro.Headers == nil {
+		ro.Headers = make(map[string]string)
+	}
+	ro.Headers["Content-Type"] = writer.FormDataContentType()
+	ro.Headers["Accept"] = "application/json"
+	ro.Body = pr
+	ro.BodyLength = 0
+
+	return c.Request(verb, p, ro)
+}
+
+// writeMultipart writes fields and then files to w in field name order and
+// closes it.
+func writeMultipart(w *multipart.Writer, fields map[string]string, files map[string]FormFile) error {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := w.WriteField(name, fields[name]); err != nil {
+			return fmt.Errorf("error writing multipart field %q: %v", name, err)
+		}
+	}
+
+	names = names[:0]
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		part, err := w.CreateFormFile(name, files[name].Name)
+		if err != nil {
+			return fmt.Errorf("error creating multipart form: %v", err)
+		}
+		if _, err := io.Copy(part, files[name].Reader); err != nil {
+			return fmt.Errorf("error copying file to multipart form: %v", err)
+		}
+	}
+
+	return w.Close()
+}
+
 func (c *Client) RequestJSON(verb, p string, i interface{}, ro *RequestOptions) (*http.Response, error) {
 	if ro == nil {
 		ro = new(RequestOptions)
@@ -408,6 +616,182 @@ func (c *Client) RequestJSONAPIBulk(verb, p string, i interface{}, ro *RequestOp
 	return c.Request(verb, p, ro)
 }
 
+// SetRateLimit throttles the client to perSecond requests per second, with
+// bursts of up to one second's worth of requests. Every HTTP attempt waits for
+// the limiter before it is sent, honouring the request's context, so bursty
+// callers are smoothed out before they trip the API's own rate limit. Retries
+// made under RetryConfig are attempts too: a retry waits for both its backoff
+// delay and the limiter. A perSecond of zero or less removes the limit, which
+// is the default.
+func (c *Client) SetRateLimit(perSecond float64) {
+	var limiter *rate.Limiter
+	if perSecond > 0 {
+		limiter = rate.NewLimiter(rate.Limit(perSecond), int(math.Ceil(perSecond)))
+	}
+
+	c.configLock.Lock()
+	c.limiter = limiter
+	c.configLock.Unlock()
+}
+
+// do sends req with the HTTP client once the rate limiter, if any, allows it.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	c.configLock.RLock()
+	limiter := c.limiter
+	c.configLock.RUnlock()
+
+	if limiter != nil {
+		if err := limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+
+	timeout, ok := req.Context().Value(requestTimeoutKey{}).(time.Duration)
+	if !ok {
+		return decompressResp(c.HTTPClient.Do(req))
+	}
+
+	// The per-request timeout replaces the client's, which would otherwise
+	// still cut the request short.
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	hc := *c.HTTPClient
+	hc.Timeout = 0
+	resp, err := hc.Do(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+	return decompressResp(resp, nil)
+}
+
+// cancelBody releases the context of a request made with a per-request
+// timeout once its response body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// decompressResp transparently decodes a gzip-encoded response body. Go's
+// transport already does this when it added the Accept-Encoding header itself,
+// in which case it drops Content-Encoding and marks the response Uncompressed;
+// this handles the case where the caller asked for gzip explicitly.
+func decompressResp(resp *http.Response, err error) (*http.Response, error) {
+	if err != nil || resp == nil || resp.Uncompressed {
+		return resp, err
+	}
+	if !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return resp, nil
+	}
+
+	resp.Body = &gzipBody{body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return resp, nil
+}
+
+// gzipBody decompresses a response body, creating the gzip reader on the
+// first read so that empty bodies, such as those of HEAD requests, do not
+// fail. Close closes the underlying body.
+type gzipBody struct {
+	body io.ReadCloser
+	zr   *gzip.Reader
+	err  error
+}
+
+func (b *gzipBody) Read(p []byte) (int, error) {
+	if b.zr == nil && b.err == nil {
+		b.zr, b.err = gzip.NewReader(b.body)
+	}
+	if b.err != nil {
+		return 0, b.err
+	}
+	return b.zr.Read(p)
+}
+
+func (b *gzipBody) Close() error {
+	if b.zr != nil {
+		b.zr.Close()
+	}
+	return b.body.Close()
+}
+
+// RateLimitRemaining returns the number of modifying requests remaining in the
+// current rate limit window, as reported by the most recent response that
+// included the Fastly-RateLimit-Remaining header.
+func (c *Client) RateLimitRemaining() int {
+	c.rateLimitLock.RLock()
+	defer c.rateLimitLock.RUnlock()
+	return c.rateLimitRemaining
+}
+
+// RateLimitReset returns the time at which the current rate limit window
+// resets, as reported by the most recent response that included the
+// Fastly-RateLimit-Reset header.
+func (c *Client) RateLimitReset() time.Time {
+	c.rateLimitLock.RLock()
+	defer c.rateLimitLock.RUnlock()
+	return c.rateLimitReset
+}
+
+// recordRateLimit wraps an HTTP request from the default client and stores any
+// rate limit headers present on the response.
+func (c *Client) recordRateLimit(resp *http.Response, err error) (*http.Response, error) {
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	remaining, okRemaining := parseRateLimitRemaining(resp.Header)
+	reset, okReset := parseRateLimitReset(resp.Header)
+	if !okRemaining && !okReset {
+		return resp, err
+	}
+
+	c.rateLimitLock.Lock()
+	defer c.rateLimitLock.Unlock()
+	if okRemaining {
+		c.rateLimitRemaining = remaining
+	}
+	if okReset {
+		c.rateLimitReset = reset
+	}
+	return resp, err
+}
+
+// parseRateLimitRemaining parses the Fastly-RateLimit-Remaining header.
+func parseRateLimitRemaining(h http.Header) (int, bool) {
+	v := h.Get(RateLimitRemainingHeader)
+	if v == "" {
+		return 0, false
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// parseRateLimitReset parses the Fastly-RateLimit-Reset header.
+func parseRateLimitReset(h http.Header) (time.Time, bool) {
+	v := h.Get(RateLimitResetHeader)
+	if v == "" {
+		return time.Time{}, false
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(n, 0), true
+}
+
 // checkResp wraps an HTTP request from the default client and verifies that the
 // request was successful. A non-200 request returns an error formatted to
 // included any validation problems or otherwise.
@@ -427,8 +811,8 @@ func checkResp(resp *http.Response, err error) (*http.Response, error) {
 }
 
 // decodeBodyMap is used to decode an HTTP response body into a mapstructure struct.
-// It closes `body`.
-func decodeBodyMap(body io.ReadCloser, out interface{}) error {
+// It closes `body`. Any hooks are applied after the default decode hooks.
+func decodeBodyMap(body io.ReadCloser, out interface{}, hooks ...mapstructure.DecodeHookFunc) error {
 	defer body.Close()
 
 	var parsed interface{}
@@ -437,18 +821,19 @@ func decodeBodyMap(body io.ReadCloser, out interface{}) error {
 		return err
 	}
 
-	return decodeMap(parsed, out)
+	return decodeMap(parsed, out, hooks...)
 }
 
 // decodeMap decodes an `in` struct or map to a mapstructure tagged `out`.
-// It applies the decoder defaults used throughout go-fastly.
+// It applies the decoder defaults used throughout go-fastly, followed by any
+// hooks specific to the caller.
 // Note that this uses opposite argument order from Go's copy().
-func decodeMap(in interface{}, out interface{}) error {
+func decodeMap(in interface{}, out interface{}, hooks ...mapstructure.DecodeHookFunc) error {
 	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
-		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(append([]mapstructure.DecodeHookFunc{
 			mapToHTTPHeaderHookFunc(),
 			stringToTimeHookFunc(),
-		),
+		}, hooks...)...),
 		WeaklyTypedInput: true,
 		Result:           out,
 	})
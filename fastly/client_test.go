@@ -0,0 +1,263 @@
+package fastly
+
+import (
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestServerClient starts a local server using the given handler and
+// returns a client pointed at it. The server is closed when the test ends.
+func newTestServerClient(t *testing.T, h http.HandlerFunc) *Client {
+	ts := httptest.NewServer(h)
+	t.Cleanup(ts.Close)
+
+	c, err := NewClientForEndpoint("", ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestClient_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			w.Header().Set(RateLimitRemainingHeader, "999")
+			w.Header().Set(RateLimitResetHeader, "1452032384")
+		}
+		w.Write([]byte(`{"status": "ok"}`))
+	})
+
+	if _, err := c.Put("/", nil); err != nil {
+		t.Fatal(err)
+	}
+	if n := c.RateLimitRemaining(); n != 999 {
+		t.Errorf("bad rate limit remaining: %d", n)
+	}
+	if r := c.RateLimitReset(); !r.Equal(time.Unix(1452032384, 0)) {
+		t.Errorf("bad rate limit reset: %s", r)
+	}
+
+	// Responses without the headers must not clear the stored values.
+	if _, err := c.Get("/", nil); err != nil {
+		t.Fatal(err)
+	}
+	if n := c.RateLimitRemaining(); n != 999 {
+		t.Errorf("bad rate limit remaining: %d", n)
+	}
+}
+
+func TestNewClientWithHTTPClient(t *testing.T) {
+	t.Parallel()
+
+	var hit bool
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	}))
+	t.Cleanup(ts.Close)
+
+	hc := &http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			hit = true
+			return http.DefaultTransport.RoundTrip(r)
+		}),
+	}
+	c, err := NewClientWithHTTPClient("", ts.URL, hc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.HTTPClient != hc {
+		t.Fatal("custom HTTP client not used")
+	}
+	if _, err := c.Get("/", nil); err != nil {
+		t.Fatal(err)
+	}
+	if !hit {
+		t.Error("request did not go through the custom transport")
+	}
+
+	c, err = NewClientWithHTTPClient("", ts.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.HTTPClient.Timeout != DefaultHTTPTimeout {
+		t.Errorf("bad default timeout: %s", c.HTTPClient.Timeout)
+	}
+	if tr, ok := c.HTTPClient.Transport.(*http.Transport); !ok || tr.DisableKeepAlives {
+		t.Error("default client should reuse connections")
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+func TestClient_UserAgent(t *testing.T) {
+	t.Parallel()
+
+	var ua string
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		ua = r.Header.Get("User-Agent")
+		w.Write([]byte(`[]`))
+	})
+
+	if _, err := c.Get("/", nil); err != nil {
+		t.Fatal(err)
+	}
+	if ua != UserAgent {
+		t.Errorf("bad default user agent: %q", ua)
+	}
+
+	c.UserAgent = "my-tool/1.0"
+	if _, err := c.Get("/", nil); err != nil {
+		t.Fatal(err)
+	}
+	if ua != "my-tool/1.0" {
+		t.Errorf("bad user agent: %q", ua)
+	}
+}
+
+func TestNewClientForEndpoint_invalid(t *testing.T) {
+	for _, endpoint := range []string{"", "api.fastly.com", "/v1"} {
+		if _, err := NewClientForEndpoint("", endpoint); err == nil {
+			t.Errorf("%q: expected error", endpoint)
+		}
+	}
+}
+
+func TestClient_SetRateLimit(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`{}`))
+	})
+	c.SetRateLimit(1)
+
+	if _, err := c.Get("/", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// The burst is spent, so the next request would have to wait about a
+	// second, which its deadline does not allow.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := c.Get("/", &RequestOptions{Context: ctx}); err == nil {
+		t.Error("expected the limiter to reject the request")
+	}
+	if calls != 1 {
+		t.Errorf("bad calls: %d", calls)
+	}
+
+	c.SetRateLimit(0)
+	if _, err := c.Get("/", nil); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Errorf("bad calls: %d", calls)
+	}
+}
+
+func TestClient_gzip(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		zw := gzip.NewWriter(w)
+		zw.Write([]byte(`{"id":"foo"}`))
+		zw.Close()
+	})
+
+	for _, headers := range []map[string]string{
+		nil, // The transport asks for and decodes gzip itself.
+		{"Accept-Encoding": "gzip"},
+	} {
+		resp, err := c.Get("/service/foo", &RequestOptions{Headers: headers})
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != `{"id":"foo"}` {
+			t.Errorf("bad body with headers %v: %q", headers, b)
+		}
+		if resp.Header.Get("Content-Encoding") != "" {
+			t.Errorf("Content-Encoding not removed: %q", resp.Header.Get("Content-Encoding"))
+		}
+	}
+}
+
+func TestClient_PostMultipart(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/upload" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Error(err)
+			return
+		}
+		if got := r.FormValue("name"); got != "pkg" {
+			t.Errorf("bad field: %q", got)
+		}
+		f, h, err := r.FormFile("package")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer f.Close()
+		b, _ := ioutil.ReadAll(f)
+		if h.Filename != "pkg.tar.gz" || string(b) != "contents" {
+			t.Errorf("bad file %q: %q", h.Filename, b)
+		}
+		w.Write([]byte(`{}`))
+	})
+
+	if _, err := c.PostMultipart("/upload", map[string]string{"name": "pkg"}, map[string]FormFile{
+		"package": {Name: "pkg.tar.gz", Reader: strings.NewReader("contents")},
+	}, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_requestTimeout(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		w.Write([]byte(`{}`))
+	})
+
+	c.HTTPClient.Timeout = 20 * time.Millisecond
+	if _, err := c.Get("/slow", nil); err == nil {
+		t.Error("expected the client timeout to apply")
+	}
+	resp, err := c.Get("/slow", &RequestOptions{Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("expected the request timeout to override the client's: %v", err)
+	}
+	resp.Body.Close()
+
+	c.HTTPClient.Timeout = 5 * time.Second
+	if _, err := c.Get("/slow", &RequestOptions{Timeout: 20 * time.Millisecond}); err == nil {
+		t.Error("expected the request timeout to apply")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := c.Get("/slow", &RequestOptions{Context: ctx, Timeout: 5 * time.Second}); err == nil {
+		t.Error("expected the earlier context deadline to apply")
+	}
+}
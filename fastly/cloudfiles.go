@@ -9,28 +9,28 @@ import (
 
 // Cloudfiles represents a Cloudfiles response from the Fastly API.
 type Cloudfiles struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name              string     `mapstructure:"name"`
-	User              string     `mapstructure:"user"`
-	AccessKey         string     `mapstructure:"access_key"`
-	BucketName        string     `mapstructure:"bucket_name"`
-	Path              string     `mapstructure:"path"`
-	Region            string     `mapstructure:"region"`
-	Placement         string     `mapstructure:"placement"`
-	Period            uint       `mapstructure:"period"`
-	GzipLevel         uint       `mapstructure:"gzip_level"`
-	Format            string     `mapstructure:"format"`
-	FormatVersion     uint       `mapstructure:"format_version"`
-	ResponseCondition string     `mapstructure:"response_condition"`
-	MessageType       string     `mapstructure:"message_type"`
-	TimestampFormat   string     `mapstructure:"timestamp_format"`
-	PublicKey         string     `mapstructure:"public_key"`
-	CompressionCodec  string     `mapstructure:"compression_codec"`
-	CreatedAt         *time.Time `mapstructure:"created_at"`
-	UpdatedAt         *time.Time `mapstructure:"updated_at"`
-	DeletedAt         *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name              string     `mapstructure:"name" json:"name"`
+	User              string     `mapstructure:"user" json:"user"`
+	AccessKey         string     `mapstructure:"access_key" json:"access_key"`
+	BucketName        string     `mapstructure:"bucket_name" json:"bucket_name"`
+	Path              string     `mapstructure:"path" json:"path"`
+	Region            string     `mapstructure:"region" json:"region"`
+	Placement         string     `mapstructure:"placement" json:"placement"`
+	Period            uint       `mapstructure:"period" json:"period"`
+	GzipLevel         uint       `mapstructure:"gzip_level" json:"gzip_level"`
+	Format            string     `mapstructure:"format" json:"format"`
+	FormatVersion     uint       `mapstructure:"format_version" json:"format_version"`
+	ResponseCondition string     `mapstructure:"response_condition" json:"response_condition"`
+	MessageType       string     `mapstructure:"message_type" json:"message_type"`
+	TimestampFormat   string     `mapstructure:"timestamp_format" json:"timestamp_format"`
+	PublicKey         string     `mapstructure:"public_key" json:"public_key"`
+	CompressionCodec  string     `mapstructure:"compression_codec" json:"compression_codec"`
+	CreatedAt         *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // cloudfilesByName is a sortable list of Cloudfiles.
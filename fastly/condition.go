@@ -9,17 +9,133 @@ import (
 
 // Condition represents a condition response from the Fastly API.
 type Condition struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name      string        `mapstructure:"name" json:"name"`
+	Comment   string        `mapstructure:"comment" json:"comment"`
+	Statement string        `mapstructure:"statement" json:"statement"`
+	Type      ConditionType `mapstructure:"type" json:"type"`
+	Priority  int           `mapstructure:"priority" json:"priority"`
+	CreatedAt *time.Time    `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt *time.Time    `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt *time.Time    `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
+}
+
+const (
+	// ConditionTypeRequest is a condition applied to the request.
+	ConditionTypeRequest ConditionType = "REQUEST"
+
+	// ConditionTypeCache is a condition applied to the response from the
+	// origin before it is cached.
+	ConditionTypeCache ConditionType = "CACHE"
+
+	// ConditionTypeResponse is a condition applied to the response before it
+	// is delivered.
+	ConditionTypeResponse ConditionType = "RESPONSE"
+
+	// ConditionTypePrefetch is a condition applied before the request is sent
+	// to the origin.
+	ConditionTypePrefetch ConditionType = "PREFETCH"
+)
+
+// ConditionType is a type of condition.
+type ConditionType string
+
+// IsValid reports whether the type is one supported by the Fastly API.
+func (t ConditionType) IsValid() bool {
+	switch t {
+	case ConditionTypeRequest, ConditionTypeCache, ConditionTypeResponse, ConditionTypePrefetch:
+		return true
+	default:
+		return false
+	}
+}
+
+// PConditionType returns pointer to ConditionType.
+func PConditionType(t ConditionType) *ConditionType {
+	ct := ConditionType(t)
+	return &ct
+}
+
+// EnableConditionChecks makes CreateHeader, CreateCacheSetting,
+// CreateResponseObject and CreateRequestSetting, and the matching Update
+// functions when they change a condition, check that the conditions they
+// reference exist on the version, and are of the right type, before sending
+// the request. A bad reference is returned as a *ConditionError
+// naming the condition, rather than the API's less helpful error. The check
+// costs one extra request whenever a condition is referenced.
+func (c *Client) EnableConditionChecks() {
+	c.configLock.Lock()
+	c.conditionChecks = true
+	c.configLock.Unlock()
+}
 
-	Name      string     `mapstructure:"name"`
-	Comment   string     `mapstructure:"comment"`
-	Statement string     `mapstructure:"statement"`
-	Type      string     `mapstructure:"type"`
-	Priority  int        `mapstructure:"priority"`
-	CreatedAt *time.Time `mapstructure:"created_at"`
-	UpdatedAt *time.Time `mapstructure:"updated_at"`
-	DeletedAt *time.Time `mapstructure:"deleted_at"`
+// conditionRef is a reference to a condition from a field of an input.
+type conditionRef struct {
+	field string
+	name  string
+	typ   ConditionType
+}
+
+// optionalConditionRef is a conditionRef for an optional field of an update
+// input. A nil name leaves the condition unchanged and is not checked.
+func optionalConditionRef(field string, name *string, typ ConditionType) conditionRef {
+	r := conditionRef{field: field, typ: typ}
+	if name != nil {
+		r.name = *name
+	}
+	return r
+}
+
+// checkConditions returns a *ConditionError for the first of refs that names
+// a condition missing from the version or of the wrong type, when condition
+// checks are enabled. Checks are skipped in DryRun mode, where the conditions
+// cannot be looked up.
+func (c *Client) checkConditions(serviceID string, serviceVersion int, refs ...conditionRef) error {
+	c.configLock.RLock()
+	enabled := c.conditionChecks
+	c.configLock.RUnlock()
+	if !enabled || c.DryRun {
+		return nil
+	}
+
+	var used []conditionRef
+	for _, r := range refs {
+		if r.name != "" {
+			used = append(used, r)
+		}
+	}
+	if len(used) == 0 {
+		return nil
+	}
+
+	conds, err := c.ListConditions(&ListConditionsInput{
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion,
+	})
+	if err != nil {
+		return err
+	}
+
+	types := make(map[string]ConditionType, len(conds))
+	for _, cond := range conds {
+		types[cond.Name] = cond.Type
+	}
+
+	for _, r := range used {
+		if t := types[r.name]; t != r.typ {
+			return &ConditionError{
+				ServiceID:      serviceID,
+				ServiceVersion: serviceVersion,
+				Field:          r.field,
+				Name:           r.name,
+				Type:           t,
+				Required:       r.typ,
+			}
+		}
+	}
+	return nil
 }
 
 // conditionsByName is a sortable list of conditions.
@@ -75,8 +191,10 @@ type CreateConditionInput struct {
 
 	Name      string `url:"name,omitempty"`
 	Statement string `url:"statement,omitempty"`
-	Type      string `url:"type,omitempty"`
-	Priority  *int   `url:"priority,omitempty"`
+
+	// Type is one of REQUEST, CACHE, RESPONSE or PREFETCH (required).
+	Type     ConditionType `url:"type,omitempty"`
+	Priority *int          `url:"priority,omitempty"`
 }
 
 // CreateCondition creates a new Fastly condition.
@@ -89,6 +207,18 @@ func (c *Client) CreateCondition(i *CreateConditionInput) (*Condition, error) {
 		return nil, ErrMissingServiceVersion
 	}
 
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	if i.Type == "" {
+		return nil, ErrMissingType
+	}
+
+	if !i.Type.IsValid() {
+		return nil, ErrInvalidConditionType
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/condition", i.ServiceID, i.ServiceVersion)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
@@ -152,10 +282,10 @@ type UpdateConditionInput struct {
 	// Name is the name of the condition to update.
 	Name string
 
-	Comment   *string `url:"comment,omitempty"`
-	Statement *string `url:"statement,omitempty"`
-	Type      *string `url:"type,omitempty"`
-	Priority  *int    `url:"priority,omitempty"`
+	Comment   *string        `url:"comment,omitempty"`
+	Statement *string        `url:"statement,omitempty"`
+	Type      *ConditionType `url:"type,omitempty"`
+	Priority  *int           `url:"priority,omitempty"`
 }
 
 // UpdateCondition updates a specific condition.
@@ -172,6 +302,10 @@ func (c *Client) UpdateCondition(i *UpdateConditionInput) (*Condition, error) {
 		return nil, ErrMissingName
 	}
 
+	if i.Type != nil && !i.Type.IsValid() {
+		return nil, ErrInvalidConditionType
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/condition/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
@@ -1,6 +1,7 @@
 package fastly
 
 import (
+	"net/http"
 	"testing"
 )
 
@@ -157,6 +158,35 @@ func TestClient_CreateCondition_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateCondition(&CreateConditionInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateCondition(&CreateConditionInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Type:           "",
+	})
+	if err != ErrMissingType {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateCondition(&CreateConditionInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Type:           "BOGUS",
+	})
+	if err != ErrInvalidConditionType {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetCondition_validation(t *testing.T) {
@@ -211,6 +241,16 @@ func TestClient_UpdateCondition_validation(t *testing.T) {
 	if err != ErrMissingName {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.UpdateCondition(&UpdateConditionInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Type:           PConditionType("BOGUS"),
+	})
+	if err != ErrInvalidConditionType {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_DeleteCondition_validation(t *testing.T) {
@@ -239,3 +279,125 @@ func TestClient_DeleteCondition_validation(t *testing.T) {
 		t.Errorf("bad error: %s", err)
 	}
 }
+
+func TestClient_EnableConditionChecks(t *testing.T) {
+	t.Parallel()
+
+	var created int
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /service/foo/version/1/condition":
+			w.Write([]byte(`[{"name":"is-api","type":"REQUEST"},{"name":"is-error","type":"CACHE"}]`))
+		case "POST /service/foo/version/1/header":
+			created++
+			w.Write([]byte(`{"name":"h"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+	c.EnableConditionChecks()
+
+	_, err := c.CreateHeader(&CreateHeaderInput{
+		ServiceID:        "foo",
+		ServiceVersion:   1,
+		Name:             "h",
+		Destination:      "http.X-Foo",
+		RequestCondition: "is-apl",
+	})
+	cerr, ok := err.(*ConditionError)
+	if !ok || cerr.Field != "RequestCondition" || cerr.Name != "is-apl" || cerr.Type != "" {
+		t.Errorf("bad error: %v", err)
+	}
+
+	_, err = c.CreateHeader(&CreateHeaderInput{
+		ServiceID:        "foo",
+		ServiceVersion:   1,
+		Name:             "h",
+		Destination:      "http.X-Foo",
+		RequestCondition: "is-error",
+	})
+	if cerr, ok := err.(*ConditionError); !ok || cerr.Type != ConditionTypeCache || cerr.Required != ConditionTypeRequest {
+		t.Errorf("bad error: %v", err)
+	}
+
+	if _, err := c.CreateHeader(&CreateHeaderInput{
+		ServiceID:        "foo",
+		ServiceVersion:   1,
+		Name:             "h",
+		Destination:      "http.X-Foo",
+		RequestCondition: "is-api",
+		CacheCondition:   "is-error",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if created != 1 {
+		t.Errorf("expected one header to be created, got %d", created)
+	}
+}
+
+func TestClient_EnableConditionChecks_update(t *testing.T) {
+	t.Parallel()
+
+	var lookups, updated int
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /service/foo/version/1/condition":
+			lookups++
+			w.Write([]byte(`[{"name":"is-api","type":"REQUEST"},{"name":"is-error","type":"CACHE"}]`))
+		case "PUT /service/foo/version/1/header/h", "PUT /service/foo/version/1/response_object/r":
+			updated++
+			w.Write([]byte(`{"name":"x"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+	c.EnableConditionChecks()
+
+	_, err := c.UpdateCacheSetting(&UpdateCacheSettingInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "cs",
+		CacheCondition: String("is-api"),
+	})
+	if cerr, ok := err.(*ConditionError); !ok || cerr.Field != "CacheCondition" || cerr.Type != ConditionTypeRequest {
+		t.Errorf("bad error: %v", err)
+	}
+
+	_, err = c.UpdateRequestSetting(&UpdateRequestSettingInput{
+		ServiceID:        "foo",
+		ServiceVersion:   1,
+		Name:             "rs",
+		RequestCondition: String("missing"),
+	})
+	if cerr, ok := err.(*ConditionError); !ok || cerr.Name != "missing" {
+		t.Errorf("bad error: %v", err)
+	}
+
+	if _, err := c.UpdateResponseObject(&UpdateResponseObjectInput{
+		ServiceID:        "foo",
+		ServiceVersion:   1,
+		Name:             "r",
+		RequestCondition: String("is-api"),
+		CacheCondition:   String("is-error"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Updates that leave the conditions alone, or clear them, need no lookup.
+	lookups = 0
+	if _, err := c.UpdateHeader(&UpdateHeaderInput{
+		ServiceID:         "foo",
+		ServiceVersion:    1,
+		Name:              "h",
+		Priority:          Uint(10),
+		ResponseCondition: String(""),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if lookups != 0 {
+		t.Errorf("expected no condition lookups, got %d", lookups)
+	}
+	if updated != 2 {
+		t.Errorf("expected two updates, got %d", updated)
+	}
+}
@@ -4,24 +4,24 @@ import "net/http"
 
 // EdgeCheck represents an edge check response from the Fastly API.
 type EdgeCheck struct {
-	Hash         string             `mapstructure:"hash"`
-	Server       string             `mapstructure:"server"`
-	ResponseTime float64            `mapstructure:"response_time"`
-	Request      *EdgeCheckRequest  `mapstructure:"request"`
-	Response     *EdgeCheckResponse `mapstructure:"response"`
+	Hash         string             `mapstructure:"hash" json:"hash"`
+	Server       string             `mapstructure:"server" json:"server"`
+	ResponseTime float64            `mapstructure:"response_time" json:"response_time"`
+	Request      *EdgeCheckRequest  `mapstructure:"request" json:"request"`
+	Response     *EdgeCheckResponse `mapstructure:"response" json:"response"`
 }
 
 // EdgeCheckRequest is the request part of an EdgeCheck response.
 type EdgeCheckRequest struct {
-	URL     string       `mapstructure:"url"`
-	Method  string       `mapstructure:"method"`
-	Headers *http.Header `mapstructure:"headers"`
+	URL     string       `mapstructure:"url" json:"url"`
+	Method  string       `mapstructure:"method" json:"method"`
+	Headers *http.Header `mapstructure:"headers" json:"headers"`
 }
 
 // EdgeCheckResponse is the response part of an EdgeCheck response.
 type EdgeCheckResponse struct {
-	Status  uint         `mapstructure:"status"`
-	Headers *http.Header `mapstructure:"headers"`
+	Status  uint         `mapstructure:"status" json:"status"`
+	Headers *http.Header `mapstructure:"headers" json:"headers"`
 }
 
 // EdgeCheckInput is used as input to the EdgeCheck function.
@@ -133,12 +133,13 @@ type CreateTLSActivationInput struct {
 	Domain        *TLSDomain            `jsonapi:"relation,tls_domain"`
 }
 
-// CreateTLSActivation enable TLS for a domain using a custom certificate.
+// CreateTLSActivation enable TLS for a domain using a custom certificate. When
+// Configuration is not set the account's default TLS configuration is used.
 func (c *Client) CreateTLSActivation(i *CreateTLSActivationInput) (*TLSActivation, error) {
-	if i.Certificate == nil {
+	if i.Certificate == nil || i.Certificate.ID == "" {
 		return nil, ErrMissingTLSCertificate
 	}
-	if i.Domain == nil {
+	if i.Domain == nil || i.Domain.ID == "" {
 		return nil, ErrMissingTLSDomain
 	}
 
@@ -185,12 +186,12 @@ func (c *Client) UpdateTLSActivation(i *UpdateTLSActivationInput) (*TLSActivatio
 	return &ta, nil
 }
 
-// DeleteTLSActivationInput used for deleting a certificate.
+// DeleteTLSActivationInput used for deleting an activation.
 type DeleteTLSActivationInput struct {
 	ID string
 }
 
-// DeleteTLSActivation destroy a certificate.
+// DeleteTLSActivation destroy an activation, disabling TLS for its domain.
 func (c *Client) DeleteTLSActivation(i *DeleteTLSActivationInput) error {
 	if i.ID == "" {
 		return ErrMissingID
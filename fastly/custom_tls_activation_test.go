@@ -132,6 +132,14 @@ func TestClient_CreateTLSActivation_validation(t *testing.T) {
 	if err != ErrMissingTLSDomain {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateTLSActivation(&CreateTLSActivationInput{
+		Certificate: &CustomTLSCertificate{ID: "CERTIFICATE_ID"},
+		Domain:      &TLSDomain{},
+	})
+	if err != ErrMissingTLSDomain {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_DeleteTLSActivation_validation(t *testing.T) {
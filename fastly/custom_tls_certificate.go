@@ -10,6 +10,9 @@ import (
 )
 
 // CustomTLSCertificate represents a custom certificate. Uses common TLSDomain type from BulkCertificate.
+//
+// NotBefore, NotAfter, Issuer and the covered Domains are parsed by Fastly from
+// the uploaded CertBlob.
 type CustomTLSCertificate struct {
 	ID                 string       `jsonapi:"primary,tls_certificate"`
 	IssuedTo           string       `jsonapi:"attr,issued_to"`
@@ -63,7 +66,8 @@ func (i *ListCustomTLSCertificatesInput) formatFilters() map[string]string {
 	return result
 }
 
-// ListCustomTLSCertificates list all certificates.
+// ListCustomTLSCertificates list all certificates. Set FilterTLSDomainsID to
+// list only the certificates covering a given TLS domain.
 func (c *Client) ListCustomTLSCertificates(i *ListCustomTLSCertificatesInput) ([]*CustomTLSCertificate, error) {
 	p := "/tls/certificates"
 	filters := &RequestOptions{
@@ -100,6 +104,7 @@ type GetCustomTLSCertificateInput struct {
 	ID string
 }
 
+// GetCustomTLSCertificate retrieves a single custom TLS certificate.
 func (c *Client) GetCustomTLSCertificate(i *GetCustomTLSCertificateInput) (*CustomTLSCertificate, error) {
 	if i.ID == "" {
 		return nil, ErrMissingID
@@ -121,6 +126,7 @@ func (c *Client) GetCustomTLSCertificate(i *GetCustomTLSCertificateInput) (*Cust
 }
 
 // CreateCustomTLSCertificateInput is used as input to the CreateCustomTLSCertificate function.
+// CertBlob is the PEM-encoded certificate (required); Name is optional.
 type CreateCustomTLSCertificateInput struct {
 	ID       string `jsonapi:"primary,tls_certificate"` // ID value does not need to be set.
 	CertBlob string `jsonapi:"attr,cert_blob"`
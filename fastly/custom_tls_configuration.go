@@ -22,7 +22,9 @@ type CustomTLSConfiguration struct {
 	UpdatedAt     *time.Time   `jsonapi:"attr,updated_at,iso8601"`
 }
 
-// DNSRecord is a child of CustomTLSConfiguration
+// DNSRecord is a child of CustomTLSConfiguration. ID holds the record value
+// (an IP address or hostname) to point a domain at, and RecordType is either
+// A, AAAA or CNAME.
 type DNSRecord struct {
 	ID         string `jsonapi:"primary,dns_record"`
 	RecordType string `jsonapi:"attr,record_type"`
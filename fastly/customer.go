@@ -0,0 +1,96 @@
+package fastly
+
+import (
+	"fmt"
+	"time"
+)
+
+// Customer represents a Fastly customer (account).
+type Customer struct {
+	ID                 string     `mapstructure:"id" json:"id"`
+	Name               string     `mapstructure:"name" json:"name"`
+	OwnerID            string     `mapstructure:"owner_id" json:"owner_id"`
+	BillingContactID   string     `mapstructure:"billing_contact_id" json:"billing_contact_id"`
+	TechnicalContactID string     `mapstructure:"technical_contact_id" json:"technical_contact_id"`
+	SecurityContactID  string     `mapstructure:"security_contact_id" json:"security_contact_id"`
+	PhoneNumber        string     `mapstructure:"phone_number" json:"phone_number"`
+	PostalAddress      string     `mapstructure:"postal_address" json:"postal_address"`
+	PricingPlan        string     `mapstructure:"pricing_plan" json:"pricing_plan"`
+	AccountType        string     `mapstructure:"account_type" json:"account_type"`
+	ForceTwoFactorAuth bool       `mapstructure:"force_2fa" json:"force_2fa"`
+	ForceSSO           bool       `mapstructure:"force_sso" json:"force_sso"`
+	CreatedAt          *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt          *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt          *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
+}
+
+// GetCurrentCustomer retrieves the customer of the authenticated user.
+func (c *Client) GetCurrentCustomer() (*Customer, error) {
+	resp, err := c.Get("/current_customer", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var cu *Customer
+	if err := decodeBodyMap(resp.Body, &cu); err != nil {
+		return nil, err
+	}
+	return cu, nil
+}
+
+// GetCustomerInput is used as input to the GetCustomer function.
+type GetCustomerInput struct {
+	ID string
+}
+
+// GetCustomer retrieves the customer with the given id.
+func (c *Client) GetCustomer(i *GetCustomerInput) (*Customer, error) {
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	path := fmt.Sprintf("/customer/%s", i.ID)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var cu *Customer
+	if err := decodeBodyMap(resp.Body, &cu); err != nil {
+		return nil, err
+	}
+	return cu, nil
+}
+
+// UpdateCustomerInput is used as input to the UpdateCustomer function. Only
+// the fields that are set are changed.
+type UpdateCustomerInput struct {
+	ID string `url:"-"`
+
+	Name               *string `url:"name,omitempty"`
+	OwnerID            *string `url:"owner_id,omitempty"`
+	BillingContactID   *string `url:"billing_contact_id,omitempty"`
+	TechnicalContactID *string `url:"technical_contact_id,omitempty"`
+	SecurityContactID  *string `url:"security_contact_id,omitempty"`
+	PhoneNumber        *string `url:"phone_number,omitempty"`
+	PostalAddress      *string `url:"postal_address,omitempty"`
+}
+
+// UpdateCustomer updates the customer with the given input.
+func (c *Client) UpdateCustomer(i *UpdateCustomerInput) (*Customer, error) {
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	path := fmt.Sprintf("/customer/%s", i.ID)
+	resp, err := c.PutForm(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var cu *Customer
+	if err := decodeBodyMap(resp.Body, &cu); err != nil {
+		return nil, err
+	}
+	return cu, nil
+}
@@ -0,0 +1,69 @@
+package fastly
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestClient_Customer(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && (r.URL.Path == "/current_customer" || r.URL.Path == "/customer/CUSTOMER_ID"):
+			w.Write([]byte(`{"id":"CUSTOMER_ID","name":"Example","owner_id":"USER_ID","force_2fa":true}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/customer/CUSTOMER_ID":
+			if err := r.ParseForm(); err != nil {
+				t.Error(err)
+				return
+			}
+			if got := r.PostForm.Encode(); got != "name=New+Name" {
+				t.Errorf("bad form: %q", got)
+			}
+			w.Write([]byte(`{"id":"CUSTOMER_ID","name":"New Name"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	cu, err := c.GetCurrentCustomer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cu.ID != "CUSTOMER_ID" || cu.OwnerID != "USER_ID" || !cu.ForceTwoFactorAuth {
+		t.Errorf("bad customer: %+v", cu)
+	}
+
+	cu, err = c.GetCustomer(&GetCustomerInput{ID: "CUSTOMER_ID"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cu.Name != "Example" {
+		t.Errorf("bad name: %q", cu.Name)
+	}
+
+	cu, err = c.UpdateCustomer(&UpdateCustomerInput{
+		ID:   "CUSTOMER_ID",
+		Name: String("New Name"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cu.Name != "New Name" {
+		t.Errorf("bad name: %q", cu.Name)
+	}
+}
+
+func TestClient_GetCustomer_validation(t *testing.T) {
+	_, err := testClient.GetCustomer(&GetCustomerInput{})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_UpdateCustomer_validation(t *testing.T) {
+	_, err := testClient.UpdateCustomer(&UpdateCustomerInput{})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+}
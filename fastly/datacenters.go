@@ -2,19 +2,19 @@ package fastly
 
 // Coordinates represent the location of a datacenter.
 type Coordinates struct {
-	Latitude   float64 `mapstructure:"latitude"`
-	Longtitude float64 `mapstructure:"longitude"`
-	X          float64 `mapstructure:"x"`
-	Y          float64 `mapstructure:"y"`
+	Latitude   float64 `mapstructure:"latitude" json:"latitude"`
+	Longtitude float64 `mapstructure:"longitude" json:"longitude"`
+	X          float64 `mapstructure:"x" json:"x"`
+	Y          float64 `mapstructure:"y" json:"y"`
 }
 
 // Datacenter is a list of Datacenters returned by the Fastly API.
 type Datacenter struct {
-	Code        string      `mapstructure:"code"`
-	Coordinates Coordinates `mapstructure:"coordinates"`
-	Group       string      `mapstructure:"group"`
-	Name        string      `mapstructure:"name"`
-	Shield      string      `mapstructure:"shield"`
+	Code        string      `mapstructure:"code" json:"code"`
+	Coordinates Coordinates `mapstructure:"coordinates" json:"coordinates"`
+	Group       string      `mapstructure:"group" json:"group"`
+	Name        string      `mapstructure:"name" json:"name"`
+	Shield      string      `mapstructure:"shield" json:"shield"`
 }
 
 // AllDatacenters returns the lists of datacenters for Fastly's network.
@@ -7,21 +7,35 @@ import (
 	"time"
 )
 
+// DatadogRegionUS is the default Datadog region.
+const DatadogRegionUS = "US"
+
+// validDatadogRegion reports whether r is a Datadog site supported by Fastly.
+// An empty value selects DatadogRegionUS.
+func validDatadogRegion(r string) bool {
+	switch r {
+	case "", DatadogRegionUS, "EU", "US3", "US5", "AP1":
+		return true
+	default:
+		return false
+	}
+}
+
 // Datadog represents a Datadog response from the Fastly API.
 type Datadog struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name              string     `mapstructure:"name"`
-	Token             string     `mapstructure:"token"`
-	Region            string     `mapstructure:"region"`
-	Format            string     `mapstructure:"format"`
-	FormatVersion     uint       `mapstructure:"format_version"`
-	ResponseCondition string     `mapstructure:"response_condition"`
-	Placement         string     `mapstructure:"placement"`
-	CreatedAt         *time.Time `mapstructure:"created_at"`
-	UpdatedAt         *time.Time `mapstructure:"updated_at"`
-	DeletedAt         *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name              string     `mapstructure:"name" json:"name"`
+	Token             string     `mapstructure:"token" json:"token"`
+	Region            string     `mapstructure:"region" json:"region"`
+	Format            string     `mapstructure:"format" json:"format"`
+	FormatVersion     uint       `mapstructure:"format_version" json:"format_version"`
+	ResponseCondition string     `mapstructure:"response_condition" json:"response_condition"`
+	Placement         string     `mapstructure:"placement" json:"placement"`
+	CreatedAt         *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // datadogByName is a sortable list of Datadog.
@@ -85,6 +99,8 @@ type CreateDatadogInput struct {
 }
 
 // CreateDatadog creates a new Datadog logging endpoint on a Fastly service version.
+// Region defaults to DatadogRegionUS when unset. Token is the Datadog API key
+// and should be treated as a secret.
 func (c *Client) CreateDatadog(i *CreateDatadogInput) (*Datadog, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
@@ -94,6 +110,20 @@ func (c *Client) CreateDatadog(i *CreateDatadogInput) (*Datadog, error) {
 		return nil, ErrMissingServiceVersion
 	}
 
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	if !validDatadogRegion(i.Region) {
+		return nil, ErrInvalidRegion
+	}
+
+	if i.Region == "" {
+		in := *i
+		in.Region = DatadogRegionUS
+		i = &in
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/logging/datadog", i.ServiceID, i.ServiceVersion)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
@@ -180,6 +210,10 @@ func (c *Client) UpdateDatadog(i *UpdateDatadogInput) (*Datadog, error) {
 		return nil, ErrMissingName
 	}
 
+	if i.Region != nil && !validDatadogRegion(*i.Region) {
+		return nil, ErrInvalidRegion
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/logging/datadog/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
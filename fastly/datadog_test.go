@@ -181,6 +181,25 @@ func TestClient_CreateDatadog_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateDatadog(&CreateDatadogInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateDatadog(&CreateDatadogInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Region:         "APAC",
+	})
+	if err != ErrInvalidRegion {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetDatadog_validation(t *testing.T) {
@@ -235,6 +254,16 @@ func TestClient_UpdateDatadog_validation(t *testing.T) {
 	if err != ErrMissingName {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.UpdateDatadog(&UpdateDatadogInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Region:         String("eu"),
+	})
+	if err != ErrInvalidRegion {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_DeleteDatadog_validation(t *testing.T) {
@@ -0,0 +1,132 @@
+package fastly
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// DebugHeader is the request header that asks Fastly to include debugging
+// information, such as the Surrogate-Key header, in its response.
+const DebugHeader = "Fastly-Debug"
+
+// DebugInfo is the debugging information Fastly returns in the response
+// headers of a request sent with the Fastly-Debug header.
+type DebugInfo struct {
+	// Path, TTL and Digest are the Fastly-Debug-Path, Fastly-Debug-TTL and
+	// Fastly-Debug-Digest headers, which trace the request through the cache
+	// nodes that handled it.
+	Path   string
+	TTL    string
+	Digest string
+
+	// ServedBy, Cache and CacheHits are the comma-separated X-Served-By,
+	// X-Cache and X-Cache-Hits headers, one entry per cache node.
+	ServedBy  []string
+	Cache     []string
+	CacheHits []string
+
+	// SurrogateKeys are the keys the object is tagged with, from the
+	// Surrogate-Key header, and SurrogateControl is the Surrogate-Control
+	// header. Fastly only returns them to debug requests.
+	SurrogateKeys    []string
+	SurrogateControl string
+}
+
+// parseDebugInfo reads the debugging headers of a response.
+func parseDebugInfo(h http.Header) *DebugInfo {
+	return &DebugInfo{
+		Path:             h.Get("Fastly-Debug-Path"),
+		TTL:              h.Get("Fastly-Debug-TTL"),
+		Digest:           h.Get("Fastly-Debug-Digest"),
+		ServedBy:         splitHeaderList(h.Get("X-Served-By")),
+		Cache:            splitHeaderList(h.Get("X-Cache")),
+		CacheHits:        splitHeaderList(h.Get("X-Cache-Hits")),
+		SurrogateKeys:    strings.Fields(h.Get("Surrogate-Key")),
+		SurrogateControl: h.Get("Surrogate-Control"),
+	}
+}
+
+// splitHeaderList splits a comma-separated header value, trimming the space
+// around each entry.
+func splitHeaderList(v string) []string {
+	if v == "" {
+		return nil
+	}
+	parts := strings.Split(v, ",")
+	for i, p := range parts {
+		parts[i] = strings.TrimSpace(p)
+	}
+	return parts
+}
+
+// GetObjectDebugInfoInput is used as input to the GetObjectDebugInfo function.
+type GetObjectDebugInfoInput struct {
+	// URL is the URL of the cached object (required). When it has no scheme,
+	// https is used.
+	URL string
+}
+
+// GetObjectDebugInfo sends a HEAD request for a cached object with the
+// Fastly-Debug header and returns the debugging information in the response.
+//
+// The request goes to the object's own host rather than the API: the API key
+// is not sent, and hooks, retries and rate limiting do not apply. In DryRun
+// mode the request is recorded and an empty DebugInfo is returned.
+func (c *Client) GetObjectDebugInfo(i *GetObjectDebugInfoInput) (*DebugInfo, error) {
+	if i.URL == "" {
+		return nil, ErrMissingURL
+	}
+
+	target := i.URL
+	if !strings.Contains(target, "://") {
+		target = "https://" + target
+	}
+	u, err := url.Parse(target)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodHead, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(DebugHeader, "1")
+	req.Header.Set("User-Agent", c.userAgent())
+
+	var resp *http.Response
+	if c.DryRun {
+		resp, err = c.recordDryRun(req)
+	} else {
+		resp, err = checkResp(c.HTTPClient.Do(req))
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+
+	return parseDebugInfo(resp.Header), nil
+}
+
+// GetObjectSurrogateKeysInput is used as input to the GetObjectSurrogateKeys
+// function.
+type GetObjectSurrogateKeysInput struct {
+	// URL is the URL of the cached object (required). When it has no scheme,
+	// https is used.
+	URL string
+}
+
+// GetObjectSurrogateKeys returns the surrogate keys a cached object is tagged
+// with, which are the keys that PurgeKey and PurgeKeys would purge it by. See
+// GetObjectDebugInfo for how the object is requested.
+func (c *Client) GetObjectSurrogateKeys(i *GetObjectSurrogateKeysInput) ([]string, error) {
+	if i.URL == "" {
+		return nil, ErrMissingURL
+	}
+
+	d, err := c.GetObjectDebugInfo(&GetObjectDebugInfoInput{URL: i.URL})
+	if err != nil {
+		return nil, err
+	}
+	return d.SurrogateKeys, nil
+}
@@ -0,0 +1,62 @@
+package fastly
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestClient_GetObjectDebugInfo(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodHead {
+			t.Errorf("bad method: %s", r.Method)
+		}
+		if r.Header.Get(DebugHeader) != "1" {
+			t.Error("missing debug header")
+		}
+		if r.Header.Get(APIKeyHeader) != "" {
+			t.Error("API key sent to object host")
+		}
+		w.Header().Set("Surrogate-Key", "post/1  posts")
+		w.Header().Set("Fastly-Debug-Digest", "abc")
+		w.Header().Set("X-Served-By", "cache-ams1, cache-atl2")
+		w.Header().Set("X-Cache", "MISS, HIT")
+	})
+
+	d, err := c.GetObjectDebugInfo(&GetObjectDebugInfoInput{URL: c.Address + "/posts/1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Digest != "abc" {
+		t.Errorf("bad digest: %q", d.Digest)
+	}
+	if !reflect.DeepEqual(d.ServedBy, []string{"cache-ams1", "cache-atl2"}) {
+		t.Errorf("bad served by: %q", d.ServedBy)
+	}
+	if !reflect.DeepEqual(d.Cache, []string{"MISS", "HIT"}) {
+		t.Errorf("bad cache: %q", d.Cache)
+	}
+
+	keys, err := c.GetObjectSurrogateKeys(&GetObjectSurrogateKeysInput{URL: c.Address + "/posts/1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(keys, []string{"post/1", "posts"}) {
+		t.Errorf("bad keys: %q", keys)
+	}
+}
+
+func TestClient_GetObjectSurrogateKeys_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetObjectSurrogateKeys(&GetObjectSurrogateKeysInput{})
+	if err != ErrMissingURL {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetObjectDebugInfo(&GetObjectDebugInfoInput{})
+	if err != ErrMissingURL {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/mitchellh/mapstructure"
@@ -71,3 +72,22 @@ func stringToTimeHookFunc() mapstructure.DecodeHookFunc {
 		return v, err
 	}
 }
+
+// stringToSliceHookFunc returns a function that converts whitespace-separated
+// strings to a []string value. It is only used when decoding Gzip
+// configurations, whose content types and extensions the API returns that way.
+func stringToSliceHookFunc() mapstructure.DecodeHookFunc {
+	return func(
+		f reflect.Type,
+		t reflect.Type,
+		data interface{}) (interface{}, error) {
+		if f.Kind() != reflect.String {
+			return data, nil
+		}
+		if t != reflect.TypeOf([]string{}) {
+			return data, nil
+		}
+
+		return strings.Fields(data.(string)), nil
+	}
+}
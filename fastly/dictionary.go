@@ -9,15 +9,15 @@ import (
 
 // Dictionary represents a dictionary response from the Fastly API.
 type Dictionary struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	ID        string     `mapstructure:"id"`
-	Name      string     `mapstructure:"name"`
-	WriteOnly bool       `mapstructure:"write_only"`
-	CreatedAt *time.Time `mapstructure:"created_at"`
-	UpdatedAt *time.Time `mapstructure:"updated_at"`
-	DeletedAt *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	ID        string     `mapstructure:"id" json:"id"`
+	Name      string     `mapstructure:"name" json:"name"`
+	WriteOnly bool       `mapstructure:"write_only" json:"write_only"`
+	CreatedAt *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // dictionariesByName is a sortable list of dictionaries.
@@ -63,6 +63,32 @@ func (c *Client) ListDictionaries(i *ListDictionariesInput) ([]*Dictionary, erro
 	return bs, nil
 }
 
+// ListServiceDictionariesInput is used as input to the
+// ListServiceDictionaries function.
+type ListServiceDictionariesInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+}
+
+// ListServiceDictionaries returns the list of dictionaries for the active
+// version of the service. It returns ErrNoActiveVersion if no version is
+// active.
+func (c *Client) ListServiceDictionaries(i *ListServiceDictionariesInput) ([]*Dictionary, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	v, err := c.ActiveVersion(&ActiveVersionInput{ServiceID: i.ServiceID})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ListDictionaries(&ListDictionariesInput{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: v.Number,
+	})
+}
+
 // CreateDictionaryInput is used as input to the CreateDictionary function.
 type CreateDictionaryInput struct {
 	// ServiceID is the ID of the service (required).
@@ -71,7 +97,11 @@ type CreateDictionaryInput struct {
 	// ServiceVersion is the specific configuration version (required).
 	ServiceVersion int
 
-	Name      string      `url:"name,omitempty"`
+	// Name is the name of the dictionary (required).
+	Name string `url:"name,omitempty"`
+
+	// WriteOnly marks the dictionary as private, hiding its items from
+	// diffs and API responses.
 	WriteOnly Compatibool `url:"write_only,omitempty"`
 }
 
@@ -85,6 +115,10 @@ func (c *Client) CreateDictionary(i *CreateDictionaryInput) (*Dictionary, error)
 		return nil, ErrMissingServiceVersion
 	}
 
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/dictionary", i.ServiceID, i.ServiceVersion)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
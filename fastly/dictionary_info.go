@@ -8,13 +8,13 @@ import (
 // DictionaryInfo represents a dictionary metadata response from the Fastly API.
 type DictionaryInfo struct {
 	// LastUpdated is the Time-stamp (GMT) when the dictionary was last updated.
-	LastUpdated *time.Time `mapstructure:"last_updated"`
+	LastUpdated *time.Time `mapstructure:"last_updated" json:"last_updated,omitempty"`
 
 	// Digest is the hash of the dictionary content.
-	Digest string `mapstructure:"digest"`
+	Digest string `mapstructure:"digest" json:"digest"`
 
 	// ItemCount is the number of items belonging to the dictionary.
-	ItemCount int `mapstructure:"item_count"`
+	ItemCount int `mapstructure:"item_count" json:"item_count"`
 }
 
 // GetDictionaryInfoInput is used as input to the GetDictionary function.
@@ -2,6 +2,7 @@ package fastly
 
 import (
 	"fmt"
+	"io"
 	"net/url"
 	"sort"
 	"strconv"
@@ -12,14 +13,14 @@ import (
 
 // DictionaryItem represents a dictionary item response from the Fastly API.
 type DictionaryItem struct {
-	ServiceID    string `mapstructure:"service_id"`
-	DictionaryID string `mapstructure:"dictionary_id"`
-	ItemKey      string `mapstructure:"item_key"`
-
-	ItemValue string     `mapstructure:"item_value"`
-	CreatedAt *time.Time `mapstructure:"created_at"`
-	UpdatedAt *time.Time `mapstructure:"updated_at"`
-	DeletedAt *time.Time `mapstructure:"deleted_at"`
+	ServiceID    string `mapstructure:"service_id" json:"service_id"`
+	DictionaryID string `mapstructure:"dictionary_id" json:"dictionary_id"`
+	ItemKey      string `mapstructure:"item_key" json:"item_key"`
+
+	ItemValue string     `mapstructure:"item_value" json:"item_value"`
+	CreatedAt *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // dictionaryItemsByKey is a sortable list of dictionary items.
@@ -45,7 +46,9 @@ type ListDictionaryItemsInput struct {
 	Sort         string
 }
 
-// ListDictionaryItems returns a list of items for a dictionary
+// ListDictionaryItems returns the items of a dictionary, following the Link
+// header through every page of results. Use NewListDictionaryItemsPaginator
+// to fetch one page at a time.
 func (c *Client) ListDictionaryItems(i *ListDictionaryItemsInput) ([]*DictionaryItem, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
@@ -56,13 +59,15 @@ func (c *Client) ListDictionaryItems(i *ListDictionaryItemsInput) ([]*Dictionary
 	}
 
 	path := fmt.Sprintf("/service/%s/dictionary/%s/items", i.ServiceID, i.DictionaryID)
-	resp, err := c.Get(path, nil)
-	if err != nil {
-		return nil, err
-	}
-
 	var bs []*DictionaryItem
-	if err := decodeBodyMap(resp.Body, &bs); err != nil {
+	if err := c.getAllPages(path, func(body io.ReadCloser) error {
+		var page []*DictionaryItem
+		if err := decodeBodyMap(body, &page); err != nil {
+			return err
+		}
+		bs = append(bs, page...)
+		return nil
+	}); err != nil {
 		return nil, err
 	}
 	sort.Stable(dictionaryItemsByKey(bs))
@@ -187,6 +192,9 @@ type CreateDictionaryItemInput struct {
 }
 
 // CreateDictionaryItem creates a new Fastly dictionary item.
+//
+// Dictionary items are not versioned: the item is live as soon as the call
+// returns, as is the case for every other item mutation in this file.
 func (c *Client) CreateDictionaryItem(i *CreateDictionaryItemInput) (*DictionaryItem, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
@@ -196,6 +204,10 @@ func (c *Client) CreateDictionaryItem(i *CreateDictionaryItemInput) (*Dictionary
 		return nil, ErrMissingDictionaryID
 	}
 
+	if i.ItemKey == "" {
+		return nil, ErrMissingItemKey
+	}
+
 	path := fmt.Sprintf("/service/%s/dictionary/%s/item", i.ServiceID, i.DictionaryID)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
@@ -277,7 +289,8 @@ type UpdateDictionaryItemInput struct {
 	ItemValue string `url:"item_value"`
 }
 
-// UpdateDictionaryItem updates a specific dictionary item.
+// UpdateDictionaryItem updates the value of an existing dictionary item. Use
+// UpsertDictionaryItem to create the item if it does not exist yet.
 func (c *Client) UpdateDictionaryItem(i *UpdateDictionaryItemInput) (*DictionaryItem, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
@@ -291,6 +304,49 @@ func (c *Client) UpdateDictionaryItem(i *UpdateDictionaryItemInput) (*Dictionary
 		return nil, ErrMissingItemKey
 	}
 
+	path := fmt.Sprintf("/service/%s/dictionary/%s/item/%s", i.ServiceID, i.DictionaryID, url.PathEscape(i.ItemKey))
+	resp, err := c.RequestForm("PATCH", path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var b *DictionaryItem
+	if err := decodeBodyMap(resp.Body, &b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// UpsertDictionaryItemInput is used as input to the UpsertDictionaryItem function.
+type UpsertDictionaryItemInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// DictionaryID is the ID of the dictionary to retrieve items for (required).
+	DictionaryID string
+
+	// ItemKey is the name of the dictionary item to create or update (required).
+	ItemKey string
+
+	// ItemValue is the value of the dictionary item (required).
+	ItemValue string `url:"item_value"`
+}
+
+// UpsertDictionaryItem creates the dictionary item, or updates its value if it
+// already exists.
+func (c *Client) UpsertDictionaryItem(i *UpsertDictionaryItemInput) (*DictionaryItem, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	if i.DictionaryID == "" {
+		return nil, ErrMissingDictionaryID
+	}
+
+	if i.ItemKey == "" {
+		return nil, ErrMissingItemKey
+	}
+
 	path := fmt.Sprintf("/service/%s/dictionary/%s/item/%s", i.ServiceID, i.DictionaryID, url.PathEscape(i.ItemKey))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
@@ -304,6 +360,8 @@ func (c *Client) UpdateDictionaryItem(i *UpdateDictionaryItemInput) (*Dictionary
 	return b, nil
 }
 
+// BatchModifyDictionaryItemsInput is the input parameter to the
+// BatchModifyDictionaryItems function.
 type BatchModifyDictionaryItemsInput struct {
 	// ServiceID is the ID of the service (required).
 	ServiceID string `json:"-"`
@@ -311,17 +369,23 @@ type BatchModifyDictionaryItemsInput struct {
 	// DictionaryID is the ID of the dictionary to modify items for (required).
 	DictionaryID string `json:"-"`
 
+	// Items is the list of operations to apply, at most
+	// BatchModifyMaximumOperations per request.
 	Items []*BatchDictionaryItem `json:"items"`
 }
 
+// BatchDictionaryItem is a single create, update, upsert or delete operation
+// within a BatchModifyDictionaryItems request.
 type BatchDictionaryItem struct {
 	Operation BatchOperation `json:"op"`
 	ItemKey   string         `json:"item_key"`
 	ItemValue string         `json:"item_value"`
 }
 
+// BatchModifyDictionaryItems applies several item operations to a dictionary
+// in a single request. It returns ErrMaxExceededItems when more than
+// BatchModifyMaximumOperations items are given.
 func (c *Client) BatchModifyDictionaryItems(i *BatchModifyDictionaryItemsInput) error {
-
 	if i.ServiceID == "" {
 		return ErrMissingServiceID
 	}
@@ -340,10 +404,104 @@ func (c *Client) BatchModifyDictionaryItems(i *BatchModifyDictionaryItemsInput)
 		return err
 	}
 
-	var batchModifyResult map[string]string
-	if err := decodeBodyMap(resp.Body, &batchModifyResult); err != nil {
+	var r *statusResp
+	if err := decodeBodyMap(resp.Body, &r); err != nil {
 		return err
 	}
+	if !r.Ok() {
+		return ErrNotOK
+	}
+
+	return nil
+}
+
+// SyncDictionaryInput is the input parameter to the SyncDictionary function.
+type SyncDictionaryInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// DictionaryID is the ID of the dictionary to sync (required).
+	DictionaryID string
+
+	// Items is the desired content of the dictionary, keyed by item key. Any
+	// item not in Items is deleted, so an empty map empties the dictionary.
+	Items map[string]string
+}
+
+// SyncDictionary makes the dictionary hold exactly the given items. It lists
+// the current items and sends only the creates, updates and deletes needed,
+// in batches of at most BatchModifyMaximumOperations. It returns
+// ErrMaxExceededItems when more than MaximumDictionarySize items are given.
+func (c *Client) SyncDictionary(i *SyncDictionaryInput) error {
+	if i.ServiceID == "" {
+		return ErrMissingServiceID
+	}
+
+	if i.DictionaryID == "" {
+		return ErrMissingDictionaryID
+	}
+
+	if len(i.Items) > MaximumDictionarySize {
+		return ErrMaxExceededItems
+	}
+
+	current := make(map[string]string)
+	p := c.NewListDictionaryItemsPaginator(&ListDictionaryItemsInput{
+		ServiceID:    i.ServiceID,
+		DictionaryID: i.DictionaryID,
+	})
+	for p.HasNext() {
+		items, err := p.GetNext()
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			current[item.ItemKey] = item.ItemValue
+		}
+	}
+	if c.DryRun {
+		return ErrDryRun
+	}
+
+	keys := make([]string, 0, len(i.Items)+len(current))
+	for k := range i.Items {
+		keys = append(keys, k)
+	}
+	for k := range current {
+		if _, ok := i.Items[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var ops []*BatchDictionaryItem
+	for _, k := range keys {
+		want, keep := i.Items[k]
+		have, exists := current[k]
+		switch {
+		case !keep:
+			ops = append(ops, &BatchDictionaryItem{Operation: DeleteBatchOperation, ItemKey: k})
+		case !exists:
+			ops = append(ops, &BatchDictionaryItem{Operation: CreateBatchOperation, ItemKey: k, ItemValue: want})
+		case want != have:
+			ops = append(ops, &BatchDictionaryItem{Operation: UpdateBatchOperation, ItemKey: k, ItemValue: want})
+		}
+	}
+
+	for len(ops) > 0 {
+		n := len(ops)
+		if n > BatchModifyMaximumOperations {
+			n = BatchModifyMaximumOperations
+		}
+		if err := c.BatchModifyDictionaryItems(&BatchModifyDictionaryItemsInput{
+			ServiceID:    i.ServiceID,
+			DictionaryID: i.DictionaryID,
+			Items:        ops[:n],
+		}); err != nil {
+			return err
+		}
+		ops = ops[n:]
+	}
 
 	return nil
 }
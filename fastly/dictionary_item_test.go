@@ -1,6 +1,8 @@
 package fastly
 
 import (
+	"encoding/json"
+	"net/http"
 	"testing"
 )
 
@@ -106,10 +108,11 @@ func TestClient_DictionaryItems(t *testing.T) {
 		t.Errorf("bad item_value: %q", retrievedDictionaryItem.ItemValue)
 	}
 
-	// Update
+	// Upsert an existing item, which updates it with a PUT. UpdateDictionaryItem
+	// sends a PATCH instead; see TestClient_UpdateDictionaryItem_patch.
 	var updatedDictionaryItem *DictionaryItem
 	record(t, fixtureBase+"update", func(c *Client) {
-		updatedDictionaryItem, err = c.UpdateDictionaryItem(&UpdateDictionaryItemInput{
+		updatedDictionaryItem, err = c.UpsertDictionaryItem(&UpsertDictionaryItemInput{
 			ServiceID:    testService.ID,
 			DictionaryID: testDictionary.ID,
 			ItemKey:      "test-dictionary-item",
@@ -170,6 +173,15 @@ func TestClient_CreateDictionaryItem_validation(t *testing.T) {
 	if err != ErrMissingDictionaryID {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateDictionaryItem(&CreateDictionaryItemInput{
+		ServiceID:    "foo",
+		DictionaryID: "test",
+		ItemKey:      "",
+	})
+	if err != ErrMissingItemKey {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetDictionaryItem_validation(t *testing.T) {
@@ -226,6 +238,65 @@ func TestClient_UpdateDictionaryItem_validation(t *testing.T) {
 	}
 }
 
+func TestClient_UpdateDictionaryItem_patch(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/service/foo/dictionary/bar/item/key" {
+			t.Errorf("bad request: %s %s", r.Method, r.URL.Path)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if got := r.PostForm.Get("item_value"); got != "new-value" {
+			t.Errorf("bad item_value: %q", got)
+		}
+		w.Write([]byte(`{"dictionary_id":"bar","service_id":"foo","item_key":"key","item_value":"new-value"}`))
+	})
+
+	item, err := c.UpdateDictionaryItem(&UpdateDictionaryItemInput{
+		ServiceID:    "foo",
+		DictionaryID: "bar",
+		ItemKey:      "key",
+		ItemValue:    "new-value",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.ItemValue != "new-value" {
+		t.Errorf("bad item_value: %q", item.ItemValue)
+	}
+}
+
+func TestClient_UpsertDictionaryItem_validation(t *testing.T) {
+	var err error
+	_, err = testClient.UpsertDictionaryItem(&UpsertDictionaryItemInput{
+		ServiceID: "",
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpsertDictionaryItem(&UpsertDictionaryItemInput{
+		ServiceID:    "foo",
+		DictionaryID: "",
+	})
+	if err != ErrMissingDictionaryID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpsertDictionaryItem(&UpsertDictionaryItemInput{
+		ServiceID:    "foo",
+		DictionaryID: "test",
+		ItemKey:      "",
+	})
+	if err != ErrMissingItemKey {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
 func TestClient_DeleteDictionaryItem_validation(t *testing.T) {
 	var err error
 	err = testClient.DeleteDictionaryItem(&DeleteDictionaryItemInput{
@@ -279,3 +350,69 @@ func TestClient_BatchModifyDictionaryItem_validation(t *testing.T) {
 		t.Errorf("bad error: %s", err)
 	}
 }
+
+func TestClient_SyncDictionary(t *testing.T) {
+	t.Parallel()
+
+	var patched []*BatchDictionaryItem
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/service/foo/dictionary/bar/items" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`[{"item_key":"keep","item_value":"1"},{"item_key":"change","item_value":"old"},{"item_key":"drop","item_value":"x"}]`))
+		case http.MethodPatch:
+			var body BatchModifyDictionaryItemsInput
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Error(err)
+				return
+			}
+			patched = append(patched, body.Items...)
+			w.Write([]byte(`{"status":"ok"}`))
+		}
+	})
+
+	if err := c.SyncDictionary(&SyncDictionaryInput{
+		ServiceID:    "foo",
+		DictionaryID: "bar",
+		Items: map[string]string{
+			"keep":   "1",
+			"change": "new",
+			"add":    "2",
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []BatchDictionaryItem{
+		{Operation: CreateBatchOperation, ItemKey: "add", ItemValue: "2"},
+		{Operation: UpdateBatchOperation, ItemKey: "change", ItemValue: "new"},
+		{Operation: DeleteBatchOperation, ItemKey: "drop"},
+	}
+	if len(patched) != len(want) {
+		t.Fatalf("bad operations: %v", patched)
+	}
+	for n := range want {
+		if *patched[n] != want[n] {
+			t.Errorf("operation %d: got %+v, want %+v", n, *patched[n], want[n])
+		}
+	}
+}
+
+func TestClient_SyncDictionary_validation(t *testing.T) {
+	var err error
+	err = testClient.SyncDictionary(&SyncDictionaryInput{
+		DictionaryID: "bar",
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.SyncDictionary(&SyncDictionaryInput{
+		ServiceID: "foo",
+	})
+	if err != ErrMissingDictionaryID {
+		t.Errorf("bad error: %s", err)
+	}
+}
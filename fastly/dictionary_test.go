@@ -1,6 +1,7 @@
 package fastly
 
 import (
+	"net/http"
 	"testing"
 )
 
@@ -141,6 +142,15 @@ func TestClient_CreateDictionary_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateDictionary(&CreateDictionaryInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetDictionary_validation(t *testing.T) {
@@ -223,3 +233,36 @@ func TestClient_DeleteDictionary_validation(t *testing.T) {
 		t.Errorf("bad error: %s", err)
 	}
 }
+
+func TestClient_ListServiceDictionaries(t *testing.T) {
+	t.Parallel()
+
+	versions := `[{"number":1},{"number":2,"active":true},{"number":3}]`
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/service/foo/version":
+			w.Write([]byte(versions))
+		case "/service/foo/version/2/dictionary":
+			w.Write([]byte(`[{"name":"b"},{"name":"a"}]`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	l, err := c.ListServiceDictionaries(&ListServiceDictionariesInput{ServiceID: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(l) != 2 || l[0].Name != "a" || l[0].Name == l[1].Name {
+		t.Errorf("bad dictionaries: %v", l)
+	}
+
+	versions = `[{"number":1},{"number":2}]`
+	if _, err := c.ListServiceDictionaries(&ListServiceDictionariesInput{ServiceID: "foo"}); err != ErrNoActiveVersion {
+		t.Errorf("bad error: %v", err)
+	}
+
+	if _, err := testClient.ListServiceDictionaries(&ListServiceDictionariesInput{}); err != ErrMissingServiceID {
+		t.Errorf("bad error: %v", err)
+	}
+}
@@ -4,10 +4,10 @@ import "fmt"
 
 // Diff represents a diff of two versions as a response from the Fastly API.
 type Diff struct {
-	Format string `mapstructure:"format"`
-	From   int    `mapstructure:"from"`
-	To     int    `mapstructure:"to"`
-	Diff   string `mapstructure:"diff"`
+	Format string `mapstructure:"format" json:"format"`
+	From   int    `mapstructure:"from" json:"from"`
+	To     int    `mapstructure:"to" json:"to"`
+	Diff   string `mapstructure:"diff" json:"diff"`
 }
 
 // GetDiffInput is used as input to the GetDiff function.
@@ -24,11 +24,20 @@ type GetDiffInput struct {
 	To int
 
 	// Format is an optional field to specify the format with which the diff will
-	// be returned. Acceptable values are "text" (default), "html", or
-	// "html_simple".
+	// be returned. Acceptable values are DiffFormatText (default), DiffFormatHTML,
+	// or DiffFormatHTMLSimple.
 	Format string
 }
 
+const (
+	// DiffFormatText returns the diff as plain text.
+	DiffFormatText = "text"
+	// DiffFormatHTML returns the diff as HTML.
+	DiffFormatHTML = "html"
+	// DiffFormatHTMLSimple returns the diff as HTML without line numbers.
+	DiffFormatHTMLSimple = "html_simple"
+)
+
 // GetDiff returns the diff of the given versions.
 func (c *Client) GetDiff(i *GetDiffInput) (*Diff, error) {
 	if i.ServiceID == "" {
@@ -43,8 +52,17 @@ func (c *Client) GetDiff(i *GetDiffInput) (*Diff, error) {
 		return nil, ErrMissingTo
 	}
 
+	var ro *RequestOptions
+	switch i.Format {
+	case "":
+	case DiffFormatText, DiffFormatHTML, DiffFormatHTMLSimple:
+		ro = &RequestOptions{Params: map[string]string{"format": i.Format}}
+	default:
+		return nil, ErrInvalidDiffFormat
+	}
+
 	path := fmt.Sprintf("service/%s/diff/from/%d/to/%d", i.ServiceID, i.From, i.To)
-	resp, err := c.Get(path, nil)
+	resp, err := c.Get(path, ro)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package fastly
 
 import (
+	"net/http"
 	"testing"
 )
 
@@ -96,4 +97,41 @@ func TestClient_Diff_validation(t *testing.T) {
 	if err != ErrMissingTo {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.GetDiff(&GetDiffInput{
+		ServiceID: "foo",
+		From:      1,
+		To:        2,
+		Format:    "json",
+	})
+	if err != ErrInvalidDiffFormat {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_GetDiff_format(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/service/foo/diff/from/1/to/2" {
+			t.Errorf("bad path: %q", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("format"); got != DiffFormatHTML {
+			t.Errorf("bad format: %q", got)
+		}
+		w.Write([]byte(`{"format":"html","from":1,"to":2,"diff":"<pre></pre>"}`))
+	})
+
+	d, err := c.GetDiff(&GetDiffInput{
+		ServiceID: "foo",
+		From:      1,
+		To:        2,
+		Format:    DiffFormatHTML,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Format != DiffFormatHTML || d.Diff != "<pre></pre>" {
+		t.Errorf("bad diff: %+v", d)
+	}
 }
@@ -9,28 +9,28 @@ import (
 
 // DigitalOcean represents a DigitalOcean response from the Fastly API.
 type DigitalOcean struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name              string     `mapstructure:"name"`
-	BucketName        string     `mapstructure:"bucket_name"`
-	Domain            string     `mapstructure:"domain"`
-	AccessKey         string     `mapstructure:"access_key"`
-	SecretKey         string     `mapstructure:"secret_key"`
-	Path              string     `mapstructure:"path"`
-	Period            uint       `mapstructure:"period"`
-	GzipLevel         uint       `mapstructure:"gzip_level"`
-	Format            string     `mapstructure:"format"`
-	FormatVersion     uint       `mapstructure:"format_version"`
-	ResponseCondition string     `mapstructure:"response_condition"`
-	MessageType       string     `mapstructure:"message_type"`
-	TimestampFormat   string     `mapstructure:"timestamp_format"`
-	Placement         string     `mapstructure:"placement"`
-	PublicKey         string     `mapstructure:"public_key"`
-	CompressionCodec  string     `mapstructure:"compression_codec"`
-	CreatedAt         *time.Time `mapstructure:"created_at"`
-	UpdatedAt         *time.Time `mapstructure:"updated_at"`
-	DeletedAt         *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name              string     `mapstructure:"name" json:"name"`
+	BucketName        string     `mapstructure:"bucket_name" json:"bucket_name"`
+	Domain            string     `mapstructure:"domain" json:"domain"`
+	AccessKey         string     `mapstructure:"access_key" json:"access_key"`
+	SecretKey         string     `mapstructure:"secret_key" json:"secret_key"`
+	Path              string     `mapstructure:"path" json:"path"`
+	Period            uint       `mapstructure:"period" json:"period"`
+	GzipLevel         uint       `mapstructure:"gzip_level" json:"gzip_level"`
+	Format            string     `mapstructure:"format" json:"format"`
+	FormatVersion     uint       `mapstructure:"format_version" json:"format_version"`
+	ResponseCondition string     `mapstructure:"response_condition" json:"response_condition"`
+	MessageType       string     `mapstructure:"message_type" json:"message_type"`
+	TimestampFormat   string     `mapstructure:"timestamp_format" json:"timestamp_format"`
+	Placement         string     `mapstructure:"placement" json:"placement"`
+	PublicKey         string     `mapstructure:"public_key" json:"public_key"`
+	CompressionCodec  string     `mapstructure:"compression_codec" json:"compression_codec"`
+	CreatedAt         *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // digitaloceansByName is a sortable list of DigitalOceans.
@@ -104,12 +104,12 @@ type CreateDigitalOceanInput struct {
 
 // CreateDigitalOcean creates a new Fastly DigitalOcean.
 func (c *Client) CreateDigitalOcean(i *CreateDigitalOceanInput) (*DigitalOcean, error) {
-	if i.ServiceID == "" {
-		return nil, ErrMissingServiceID
+	if err := validateServiceVersionName(i.ServiceID, i.ServiceVersion, i.Name); err != nil {
+		return nil, err
 	}
 
-	if i.ServiceVersion == 0 {
-		return nil, ErrMissingServiceVersion
+	if i.GzipLevel != 0 && i.CompressionCodec != "" {
+		return nil, ErrGzipLevelWithCompressionCodec
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/logging/digitalocean", i.ServiceID, i.ServiceVersion)
@@ -207,6 +207,10 @@ func (c *Client) UpdateDigitalOcean(i *UpdateDigitalOceanInput) (*DigitalOcean,
 		return nil, ErrMissingName
 	}
 
+	if i.GzipLevel != nil && *i.GzipLevel != 0 && i.CompressionCodec != nil && *i.CompressionCodec != "" {
+		return nil, ErrGzipLevelWithCompressionCodec
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/logging/digitalocean/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
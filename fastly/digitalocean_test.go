@@ -384,6 +384,26 @@ func TestClient_CreateDigitalOcean_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateDigitalOcean(&CreateDigitalOceanInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateDigitalOcean(&CreateDigitalOceanInput{
+		ServiceID:        "foo",
+		ServiceVersion:   1,
+		Name:             "test",
+		CompressionCodec: "snappy",
+		GzipLevel:        8,
+	})
+	if err != ErrGzipLevelWithCompressionCodec {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetDigitalOcean_validation(t *testing.T) {
@@ -438,6 +458,17 @@ func TestClient_UpdateDigitalOcean_validation(t *testing.T) {
 	if err != ErrMissingName {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.UpdateDigitalOcean(&UpdateDigitalOceanInput{
+		ServiceID:        "foo",
+		ServiceVersion:   1,
+		Name:             "test",
+		CompressionCodec: String("zstd"),
+		GzipLevel:        Uint(9),
+	})
+	if err != ErrGzipLevelWithCompressionCodec {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_DeleteDigitalOcean_validation(t *testing.T) {
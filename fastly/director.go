@@ -24,21 +24,38 @@ const (
 // DirectorType is a type of director.
 type DirectorType uint8
 
+// IsValid reports whether the director type is one supported by the Fastly
+// API.
+func (t DirectorType) IsValid() bool {
+	switch t {
+	case DirectorTypeRandom, DirectorTypeRoundRobin, DirectorTypeHash, DirectorTypeClient:
+		return true
+	default:
+		return false
+	}
+}
+
+// validQuorum reports whether q is a valid quorum percentage.
+func validQuorum(q uint) bool {
+	return q <= 100
+}
+
 // Director represents a director response from the Fastly API.
 type Director struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name      string       `mapstructure:"name"`
-	Comment   string       `mapstructure:"comment"`
-	Shield    string       `mapstructure:"shield"`
-	Quorum    uint         `mapstructure:"quorum"`
-	Type      DirectorType `mapstructure:"type"`
-	Retries   uint         `mapstructure:"retries"`
-	Capacity  uint         `mapstructure:"capacity"`
-	CreatedAt *time.Time   `mapstructure:"created_at"`
-	UpdatedAt *time.Time   `mapstructure:"updated_at"`
-	DeletedAt *time.Time   `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name      string       `mapstructure:"name" json:"name"`
+	Comment   string       `mapstructure:"comment" json:"comment"`
+	Shield    string       `mapstructure:"shield" json:"shield"`
+	Quorum    uint         `mapstructure:"quorum" json:"quorum"`
+	Type      DirectorType `mapstructure:"type" json:"type"`
+	Retries   uint         `mapstructure:"retries" json:"retries"`
+	Capacity  uint         `mapstructure:"capacity" json:"capacity"`
+	Backends  []string     `mapstructure:"backends" json:"backends"`
+	CreatedAt *time.Time   `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt *time.Time   `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt *time.Time   `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // directorsByName is a sortable list of directors.
@@ -111,6 +128,18 @@ func (c *Client) CreateDirector(i *CreateDirectorInput) (*Director, error) {
 		return nil, ErrMissingServiceVersion
 	}
 
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	if i.Type != 0 && !i.Type.IsValid() {
+		return nil, ErrInvalidDirectorType
+	}
+
+	if i.Quorum != nil && !validQuorum(*i.Quorum) {
+		return nil, ErrInvalidQuorum
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/director", i.ServiceID, i.ServiceVersion)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
@@ -197,6 +226,14 @@ func (c *Client) UpdateDirector(i *UpdateDirectorInput) (*Director, error) {
 		return nil, ErrMissingName
 	}
 
+	if i.Type != 0 && !i.Type.IsValid() {
+		return nil, ErrInvalidDirectorType
+	}
+
+	if i.Quorum != nil && !validQuorum(*i.Quorum) {
+		return nil, ErrInvalidQuorum
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/director/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
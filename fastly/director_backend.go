@@ -9,14 +9,14 @@ import (
 // DirectorBackend is the relationship between a director and a backend in the
 // Fastly API.
 type DirectorBackend struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Director  string     `mapstructure:"director_name"`
-	Backend   string     `mapstructure:"backend_name"`
-	CreatedAt *time.Time `mapstructure:"created_at"`
-	UpdatedAt *time.Time `mapstructure:"updated_at"`
-	DeletedAt *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Director  string     `mapstructure:"director_name" json:"director_name"`
+	Backend   string     `mapstructure:"backend_name" json:"backend_name"`
+	CreatedAt *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // CreateDirectorBackendInput is used as input to the CreateDirectorBackend
@@ -164,6 +164,35 @@ func TestClient_CreateDirector_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateDirector(&CreateDirectorInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateDirector(&CreateDirectorInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Type:           DirectorType(5),
+	})
+	if err != ErrInvalidDirectorType {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateDirector(&CreateDirectorInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Quorum:         Uint(101),
+	})
+	if err != ErrInvalidQuorum {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetDirector_validation(t *testing.T) {
@@ -218,6 +247,16 @@ func TestClient_UpdateDirector_validation(t *testing.T) {
 	if err != ErrMissingName {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.UpdateDirector(&UpdateDirectorInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Quorum:         Uint(200),
+	})
+	if err != ErrInvalidQuorum {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_DeleteDirector_validation(t *testing.T) {
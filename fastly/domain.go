@@ -1,6 +1,7 @@
 package fastly
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -11,14 +12,19 @@ import (
 
 // Domain represents the the domain name Fastly will serve content for.
 type Domain struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name      string     `mapstructure:"name"`
-	Comment   string     `mapstructure:"comment"`
-	CreatedAt *time.Time `mapstructure:"created_at"`
-	UpdatedAt *time.Time `mapstructure:"updated_at"`
-	DeletedAt *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name      string     `mapstructure:"name" json:"name"`
+	Comment   string     `mapstructure:"comment" json:"comment"`
+	CreatedAt *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
+
+	// Existing is set when CreateDomain returned a domain that already
+	// existed on the version rather than creating it. See
+	// CreateDomainInput.ReturnExistingOnConflict.
+	Existing bool `mapstructure:"-" json:"-"`
 }
 
 // domainsByName is a sortable list of backends.
@@ -77,6 +83,12 @@ type CreateDomainInput struct {
 
 	// Comment is a personal, freeform descriptive note.
 	Comment string `url:"comment,omitempty"`
+
+	// ReturnExistingOnConflict, when the API reports that the domain already
+	// exists, returns the domain on this version with Existing set instead of
+	// an error. If the domain belongs to another service, the error is
+	// returned as usual.
+	ReturnExistingOnConflict bool `url:"-"`
 }
 
 // CreateDomain creates a new domain with the given information.
@@ -89,9 +101,25 @@ func (c *Client) CreateDomain(i *CreateDomainInput) (*Domain, error) {
 		return nil, ErrMissingServiceVersion
 	}
 
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/domain", i.ServiceID, i.ServiceVersion)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
+		if herr, ok := err.(*HTTPError); ok && i.ReturnExistingOnConflict && herr.isConflict() {
+			d, gerr := c.GetDomain(&GetDomainInput{
+				ServiceID:      i.ServiceID,
+				ServiceVersion: i.ServiceVersion,
+				Name:           i.Name,
+			})
+			if gerr != nil {
+				return nil, err
+			}
+			d.Existing = true
+			return d, nil
+		}
 		return nil, err
 	}
 
@@ -160,7 +188,8 @@ type UpdateDomainInput struct {
 }
 
 // UpdateDomain updates a single domain for the current service. The only allowed
-// parameters are `Name` and `Comment`.
+// parameters are `Name` and `Comment`. The domain is addressed by its current
+// `Name`, so a rename is done by setting `NewName`.
 func (c *Client) UpdateDomain(i *UpdateDomainInput) (*Domain, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
@@ -218,10 +247,180 @@ func (c *Client) DeleteDomain(i *DeleteDomainInput) error {
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/domain/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
-	_, err := c.Delete(path, nil)
+	resp, err := c.Delete(path, nil)
 	if err != nil {
 		return err
 	}
+
+	var r *statusResp
+	if err := decodeBodyMap(resp.Body, &r); err != nil {
+		return err
+	}
+	if !r.Ok() {
+		return ErrNotOK
+	}
+	return nil
+}
+
+// CopyDomainInput is used as input to the CopyDomain function.
+type CopyDomainInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// From is the configuration version to copy the domain from (required).
+	From int
+
+	// To is the configuration version to copy the domain to (required).
+	To int
+
+	// Name is the name of the domain to copy (required).
+	Name string
+}
+
+// CopyDomain copies the named domain from one version of a service to
+// another and returns the new domain.
+func (c *Client) CopyDomain(i *CopyDomainInput) (*Domain, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	if i.From == 0 {
+		return nil, ErrMissingFrom
+	}
+
+	if i.To == 0 {
+		return nil, ErrMissingTo
+	}
+
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	d, err := c.GetDomain(&GetDomainInput{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.From,
+		Name:           i.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if c.DryRun {
+		return nil, ErrDryRun
+	}
+
+	return c.CreateDomain(&CreateDomainInput{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.To,
+		Name:           d.Name,
+		Comment:        d.Comment,
+	})
+}
+
+// AddDomainsInput is used as input to the AddDomains function.
+type AddDomainsInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// ServiceVersion is the specific configuration version (required).
+	ServiceVersion int
+
+	// Names are the names of the domains to add (required).
+	Names []string
+}
+
+// AddDomains adds each of the named domains to a version of a service and
+// returns the domains that were added. Domains that could not be added are
+// reported through a *MultiError keyed by name, alongside the domains that
+// were.
+//
+// The client sends modifying requests one at a time, so the domains are
+// created in turn rather than in parallel. Cancelling ctx stops before the
+// next domain is created.
+func (c *Client) AddDomains(ctx context.Context, i *AddDomainsInput) ([]*Domain, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	if i.ServiceVersion == 0 {
+		return nil, ErrMissingServiceVersion
+	}
+
+	if len(i.Names) == 0 {
+		return nil, ErrMissingNames
+	}
+
+	var added []*Domain
+	merr := &MultiError{Errors: map[string]error{}}
+	for _, name := range i.Names {
+		if err := ctx.Err(); err != nil {
+			return added, err
+		}
+
+		d, err := c.CreateDomain(&CreateDomainInput{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           name,
+		})
+		if err != nil {
+			merr.Errors[name] = err
+			continue
+		}
+		added = append(added, d)
+	}
+
+	if len(merr.Errors) > 0 {
+		return added, merr
+	}
+	return added, nil
+}
+
+// RemoveDomainsInput is used as input to the RemoveDomains function.
+type RemoveDomainsInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// ServiceVersion is the specific configuration version (required).
+	ServiceVersion int
+
+	// Names are the names of the domains to remove (required).
+	Names []string
+}
+
+// RemoveDomains removes each of the named domains from a version of a
+// service. Domains that could not be removed are reported through a
+// *MultiError keyed by name. As with AddDomains, the domains are removed in
+// turn and cancelling ctx stops before the next one.
+func (c *Client) RemoveDomains(ctx context.Context, i *RemoveDomainsInput) error {
+	if i.ServiceID == "" {
+		return ErrMissingServiceID
+	}
+
+	if i.ServiceVersion == 0 {
+		return ErrMissingServiceVersion
+	}
+
+	if len(i.Names) == 0 {
+		return ErrMissingNames
+	}
+
+	merr := &MultiError{Errors: map[string]error{}}
+	for _, name := range i.Names {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if err := c.DeleteDomain(&DeleteDomainInput{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           name,
+		}); err != nil {
+			merr.Errors[name] = err
+		}
+	}
+
+	if len(merr.Errors) > 0 {
+		return merr
+	}
 	return nil
 }
 
@@ -237,7 +436,8 @@ type ValidateDomainInput struct {
 	Name string `url:"name"`
 }
 
-// ValidateDomain validates the given domain.
+// ValidateDomain checks the DNS status of the given domain, reporting its
+// CNAME and whether it points at Fastly.
 func (c *Client) ValidateDomain(i *ValidateDomainInput) (*DomainValidationResult, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
@@ -328,7 +528,8 @@ type ValidateAllDomainsInput struct {
 	ServiceVersion int
 }
 
-// ValidateAllDomains validates the given domain.
+// ValidateAllDomains checks the DNS status of every domain on the given
+// version, reporting whether each one points at Fastly.
 func (c *Client) ValidateAllDomains(i *ValidateAllDomainsInput) (results []*DomainValidationResult, err error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
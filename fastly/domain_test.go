@@ -1,6 +1,8 @@
 package fastly
 
 import (
+	"context"
+	"net/http"
 	"testing"
 )
 
@@ -207,6 +209,15 @@ func TestClient_CreateDomain_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateDomain(&CreateDomainInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetDomain_validation(t *testing.T) {
@@ -325,3 +336,165 @@ func TestClient_ValidateDomain_validation(t *testing.T) {
 		t.Errorf("bad error: %s", err)
 	}
 }
+
+func TestClient_ValidateAllDomains_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ValidateAllDomains(&ValidateAllDomainsInput{
+		ServiceID: "",
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ValidateAllDomains(&ValidateAllDomainsInput{
+		ServiceID:      "foo",
+		ServiceVersion: 0,
+	})
+	if err != ErrMissingServiceVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_CopyDomain_validation(t *testing.T) {
+	var err error
+	_, err = testClient.CopyDomain(&CopyDomainInput{
+		From: 1,
+		To:   2,
+		Name: "example.com",
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CopyDomain(&CopyDomainInput{
+		ServiceID: "foo",
+		From:      1,
+		To:        2,
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_AddDomains(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/service/foo/version/1/domain" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		r.ParseForm()
+		if r.PostForm.Get("name") == "bad.example.com" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"msg":"Duplicate record"}`))
+			return
+		}
+		w.Write([]byte(`{"service_id":"foo","version":1,"name":"` + r.PostForm.Get("name") + `"}`))
+	})
+
+	ds, err := c.AddDomains(context.Background(), &AddDomainsInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Names:          []string{"a.example.com", "bad.example.com", "b.example.com"},
+	})
+	merr, ok := err.(*MultiError)
+	if !ok {
+		t.Fatalf("expected *MultiError, got %v", err)
+	}
+	if len(merr.Errors) != 1 || merr.Errors["bad.example.com"] == nil {
+		t.Errorf("bad errors: %v", merr.Errors)
+	}
+	if len(ds) != 2 || ds[0].Name != "a.example.com" || ds[1].Name != "b.example.com" {
+		t.Errorf("bad domains: %v", ds)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := c.AddDomains(ctx, &AddDomainsInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Names:          []string{"a.example.com"},
+	}); err != context.Canceled {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestClient_RemoveDomains(t *testing.T) {
+	t.Parallel()
+
+	var removed []string
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		removed = append(removed, r.URL.Path)
+		w.Write([]byte(`{"status":"ok"}`))
+	})
+
+	if err := c.RemoveDomains(context.Background(), &RemoveDomainsInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Names:          []string{"a.example.com", "b.example.com"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(removed) != 2 || removed[0] != "/service/foo/version/1/domain/a.example.com" || removed[1] != "/service/foo/version/1/domain/b.example.com" {
+		t.Errorf("bad requests: %v", removed)
+	}
+}
+
+func TestClient_AddDomains_validation(t *testing.T) {
+	var err error
+	_, err = testClient.AddDomains(context.Background(), &AddDomainsInput{
+		ServiceVersion: 1,
+		Names:          []string{"example.com"},
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.AddDomains(context.Background(), &AddDomainsInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+	})
+	if err != ErrMissingNames {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.RemoveDomains(context.Background(), &RemoveDomainsInput{
+		ServiceID: "foo",
+		Names:     []string{"example.com"},
+	})
+	if err != ErrMissingServiceVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_CreateDomain_returnExistingOnConflict(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/service/foo/version/1/domain":
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"msg":"Duplicate domain","detail":"example.com already exists on this version"}`))
+		case r.Method == "GET" && r.URL.Path == "/service/foo/version/1/domain/example.com":
+			w.Write([]byte(`{"service_id":"foo","version":1,"name":"example.com"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	d, err := c.CreateDomain(&CreateDomainInput{
+		ServiceID:                "foo",
+		ServiceVersion:           1,
+		Name:                     "example.com",
+		ReturnExistingOnConflict: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Name != "example.com" || !d.Existing {
+		t.Errorf("bad domain: %+v", d)
+	}
+}
@@ -0,0 +1,72 @@
+package fastly
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// RecordedRequest is a request captured instead of being sent while the
+// client's DryRun mode is enabled.
+type RecordedRequest struct {
+	// Method is the HTTP method, such as "PUT".
+	Method string
+
+	// Path is the URL path, such as "/service/SERVICE_ID/version/1/backend".
+	Path string
+
+	// Query is the encoded query string, without the leading "?".
+	Query string
+
+	// Body is the encoded request body, if any.
+	Body []byte
+}
+
+// RecordedRequests returns the requests captured so far while DryRun was
+// enabled, in the order they were made.
+func (c *Client) RecordedRequests() []RecordedRequest {
+	c.dryRunLock.Lock()
+	defer c.dryRunLock.Unlock()
+	return append([]RecordedRequest(nil), c.recordedRequests...)
+}
+
+// recordDryRun captures req and returns a response without sending anything.
+// The response carries a JSON null body, so most functions return nil
+// results, except for deletions, which receive {"status":"ok"}. Functions
+// that would go on to use such a result return ErrDryRun instead.
+func (c *Client) recordDryRun(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		b, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = b
+	}
+
+	c.dryRunLock.Lock()
+	c.recordedRequests = append(c.recordedRequests, RecordedRequest{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.RawQuery,
+		Body:   body,
+	})
+	c.dryRunLock.Unlock()
+
+	respBody := "null"
+	if req.Method == http.MethodDelete {
+		respBody = `{"status":"ok"}`
+	}
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          ioutil.NopCloser(strings.NewReader(respBody)),
+		ContentLength: int64(len(respBody)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,129 @@
+package fastly
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestClient_DryRun(t *testing.T) {
+	t.Parallel()
+
+	// Nothing listens on this endpoint, so any real request would fail.
+	c, err := NewClientForEndpoint("key", "http://127.0.0.1:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.DryRun = true
+
+	b, err := c.CreateBackend(&CreateBackendInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "origin",
+		Address:        "example.com",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b != nil {
+		t.Errorf("expected a zero-value result, got %+v", b)
+	}
+
+	if err := c.DeleteBackend(&DeleteBackendInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "origin",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	rs := c.RecordedRequests()
+	if len(rs) != 2 {
+		t.Fatalf("bad recorded requests: %+v", rs)
+	}
+	if rs[0].Method != "POST" || rs[0].Path != "/service/foo/version/1/backend" {
+		t.Errorf("bad request: %s %s", rs[0].Method, rs[0].Path)
+	}
+	if got := string(rs[0].Body); got != "ServiceID=foo&ServiceVersion=1&address=example.com&name=origin&ssl_check_cert=0" {
+		t.Errorf("bad body: %q", got)
+	}
+	if rs[1].Method != "DELETE" || rs[1].Path != "/service/foo/version/1/backend/origin" {
+		t.Errorf("bad request: %s %s", rs[1].Method, rs[1].Path)
+	}
+}
+
+func TestClient_DryRun_debugInfo(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClientForEndpoint("key", "http://127.0.0.1:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.DryRun = true
+
+	// Nothing listens on the object's host either.
+	keys, err := c.GetObjectSurrogateKeys(&GetObjectSurrogateKeysInput{URL: "http://127.0.0.1:1/image.png"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 0 {
+		t.Errorf("expected no surrogate keys, got %v", keys)
+	}
+
+	rs := c.RecordedRequests()
+	if len(rs) != 1 || rs[0].Method != "HEAD" || rs[0].Path != "/image.png" {
+		t.Errorf("bad recorded requests: %+v", rs)
+	}
+}
+
+func TestClient_DryRun_multiStep(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClientForEndpoint("key", "http://127.0.0.1:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.DryRun = true
+	c.EnableServiceTypeChecks()
+	c.EnableConditionChecks()
+
+	if _, err := c.CopyBackend(&CopyBackendInput{ServiceID: "foo", From: 1, To: 2, Name: "origin"}); err != ErrDryRun {
+		t.Errorf("CopyBackend: bad error: %v", err)
+	}
+	if _, _, err := c.CreateServiceWithDomain(&CreateServiceWithDomainInput{Name: "foo", Domain: "example.com", Backend: &CreateBackendInput{Name: "origin", Address: "example.com"}}); err != ErrDryRun {
+		t.Errorf("CreateServiceWithDomain: bad error: %v", err)
+	}
+	if _, err := c.ImportVersion(&ImportVersionInput{ServiceID: "foo", Export: &VersionExport{}}); err != ErrDryRun {
+		t.Errorf("ImportVersion: bad error: %v", err)
+	}
+	if _, err := c.PurgeKey(&PurgeKeyInput{ServiceID: "foo", Key: "bar", Debug: true}); err != nil {
+		t.Errorf("PurgeKey: %v", err)
+	}
+	if _, err := c.CreateHeader(&CreateHeaderInput{
+		ServiceID:        "foo",
+		ServiceVersion:   1,
+		Name:             "h",
+		Action:           HeaderActionSet,
+		Type:             HeaderTypeRequest,
+		Destination:      "http.X",
+		RequestCondition: "cond",
+	}); err != nil {
+		t.Errorf("CreateHeader: %v", err)
+	}
+
+	// Each operation records the requests it made before stopping.
+	rs := c.RecordedRequests()
+	var got []string
+	for _, r := range rs {
+		got = append(got, r.Method+" "+r.Path)
+	}
+	expected := []string{
+		"GET /service/foo/version/1/backend/origin",
+		"POST /service",
+		"POST /service/foo/version",
+		"POST /service/foo/purge/bar",
+		"POST /service/foo/version/1/header",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("bad recorded requests: %q", got)
+	}
+}
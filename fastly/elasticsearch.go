@@ -9,28 +9,28 @@ import (
 
 // Elasticsearch represents an Elasticsearch Logging response from the Fastly API.
 type Elasticsearch struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name              string     `mapstructure:"name"`
-	ResponseCondition string     `mapstructure:"response_condition"`
-	Format            string     `mapstructure:"format"`
-	Index             string     `mapstructure:"index"`
-	URL               string     `mapstructure:"url"`
-	Pipeline          string     `mapstructure:"pipeline"`
-	User              string     `mapstructure:"user"`
-	Password          string     `mapstructure:"password"`
-	RequestMaxEntries uint       `mapstructure:"request_max_entries"`
-	RequestMaxBytes   uint       `mapstructure:"request_max_bytes"`
-	Placement         string     `mapstructure:"placement"`
-	TLSCACert         string     `mapstructure:"tls_ca_cert"`
-	TLSClientCert     string     `mapstructure:"tls_client_cert"`
-	TLSClientKey      string     `mapstructure:"tls_client_key"`
-	TLSHostname       string     `mapstructure:"tls_hostname"`
-	FormatVersion     uint       `mapstructure:"format_version"`
-	CreatedAt         *time.Time `mapstructure:"created_at"`
-	UpdatedAt         *time.Time `mapstructure:"updated_at"`
-	DeletedAt         *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name              string     `mapstructure:"name" json:"name"`
+	ResponseCondition string     `mapstructure:"response_condition" json:"response_condition"`
+	Format            string     `mapstructure:"format" json:"format"`
+	Index             string     `mapstructure:"index" json:"index"`
+	URL               string     `mapstructure:"url" json:"url"`
+	Pipeline          string     `mapstructure:"pipeline" json:"pipeline"`
+	User              string     `mapstructure:"user" json:"user"`
+	Password          string     `mapstructure:"password" json:"password"`
+	RequestMaxEntries uint       `mapstructure:"request_max_entries" json:"request_max_entries"`
+	RequestMaxBytes   uint       `mapstructure:"request_max_bytes" json:"request_max_bytes"`
+	Placement         string     `mapstructure:"placement" json:"placement"`
+	TLSCACert         string     `mapstructure:"tls_ca_cert" json:"tls_ca_cert"`
+	TLSClientCert     string     `mapstructure:"tls_client_cert" json:"tls_client_cert"`
+	TLSClientKey      string     `mapstructure:"tls_client_key" json:"tls_client_key"`
+	TLSHostname       string     `mapstructure:"tls_hostname" json:"tls_hostname"`
+	FormatVersion     uint       `mapstructure:"format_version" json:"format_version"`
+	CreatedAt         *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // elasticsearchByName is a sortable list of Elasticsearch logs.
@@ -103,13 +103,14 @@ type CreateElasticsearchInput struct {
 }
 
 // CreateElasticsearch creates a new Fastly Elasticsearch logging endpoint.
+// Index may contain strftime placeholders and is sent unchanged.
 func (c *Client) CreateElasticsearch(i *CreateElasticsearchInput) (*Elasticsearch, error) {
-	if i.ServiceID == "" {
-		return nil, ErrMissingServiceID
+	if err := validateServiceVersionName(i.ServiceID, i.ServiceVersion, i.Name); err != nil {
+		return nil, err
 	}
 
-	if i.ServiceVersion == 0 {
-		return nil, ErrMissingServiceVersion
+	if err := validateTLSMaterial(i.TLSCACert, i.TLSClientCert, i.TLSClientKey); err != nil {
+		return nil, err
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/logging/elasticsearch", i.ServiceID, i.ServiceVersion)
@@ -205,6 +206,20 @@ func (c *Client) UpdateElasticsearch(i *UpdateElasticsearchInput) (*Elasticsearc
 		return nil, ErrMissingName
 	}
 
+	var caCert, clientCert, clientKey string
+	if i.TLSCACert != nil {
+		caCert = *i.TLSCACert
+	}
+	if i.TLSClientCert != nil {
+		clientCert = *i.TLSClientCert
+	}
+	if i.TLSClientKey != nil {
+		clientKey = *i.TLSClientKey
+	}
+	if err := validateTLSMaterial(caCert, clientCert, clientKey); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/logging/elasticsearch/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
@@ -266,6 +266,25 @@ func TestClient_CreateElasticsearch_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateElasticsearch(&CreateElasticsearchInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateElasticsearch(&CreateElasticsearchInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		TLSCACert:      "not a certificate",
+	})
+	if err != ErrInvalidTLSCACert {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetElasticsearch_validation(t *testing.T) {
@@ -320,6 +339,16 @@ func TestClient_UpdateElasticsearch_validation(t *testing.T) {
 	if err != ErrMissingName {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.UpdateElasticsearch(&UpdateElasticsearchInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		TLSClientKey:   String("not a key"),
+	})
+	if err != ErrInvalidTLSClientKey {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_DeleteElasticsearch_validation(t *testing.T) {
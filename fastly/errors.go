@@ -5,6 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/google/jsonapi"
 )
@@ -67,6 +70,10 @@ var ErrMissingACLID = NewFieldError("ACLID")
 // requires a "Address" key, but one was not set.
 var ErrMissingAddress = NewFieldError("Address")
 
+// ErrMissingAuthMethod is an error that is returned when an input struct
+// specifies a SASL "User" or "Password" without an "AuthMethod".
+var ErrMissingAuthMethod = NewFieldError("AuthMethod").Message("is required when User or Password is set")
+
 // ErrMissingBackend is an error that is returned when an input struct
 // requires a "Backend" key, but one was not set.
 var ErrMissingBackend = NewFieldError("Backend")
@@ -75,6 +82,14 @@ var ErrMissingBackend = NewFieldError("Backend")
 // requires a "CertBlob" key, but one was not set.
 var ErrMissingCertBlob = NewFieldError("CertBlob")
 
+// ErrMissingComment is an error that is returned when an input struct
+// requires a "Comment" key, but one was not set.
+var ErrMissingComment = NewFieldError("Comment")
+
+// ErrMissingConfigurations is an error that is returned when an input struct
+// requires a "Configurations" key, but one was not set.
+var ErrMissingConfigurations = NewFieldError("Configurations")
+
 // ErrMissingContent is an error that is returned when an input struct
 // requires a "Content" key, but one was not set.
 var ErrMissingContent = NewFieldError("Content")
@@ -83,10 +98,189 @@ var ErrMissingContent = NewFieldError("Content")
 // requires a "Type" key, but one was not set.
 var ErrMissingType = NewFieldError("Type")
 
+// ErrInvalidCacheSettingAction is an error that is returned when an input
+// struct specifies an "Action" key that is not a known cache setting action.
+var ErrInvalidCacheSettingAction = NewFieldError("Action").Message("must be one of cache, pass or restart, or empty")
+
+// ErrInvalidConditionType is an error that is returned when an input struct
+// specifies a "Type" key that is not a known condition type.
+var ErrInvalidConditionType = NewFieldError("Type").Message("must be one of REQUEST, CACHE, RESPONSE or PREFETCH")
+
+// ErrInvalidHeaderAction is an error that is returned when an input struct
+// specifies an "Action" key that is not a known header action.
+var ErrInvalidHeaderAction = NewFieldError("Action").Message("must be one of set, append, delete, regex or regex_repeat")
+
+// ErrInvalidHeaderType is an error that is returned when an input struct
+// specifies a "Type" key that is not a known header type.
+var ErrInvalidHeaderType = NewFieldError("Type").Message("must be one of request, fetch, cache or response")
+
+// ErrInvalidMinTLSVersion is an error that is returned when an input struct
+// specifies a "MinTLSVersion" that is not a supported TLS version.
+var ErrInvalidMinTLSVersion = NewFieldError("MinTLSVersion").Message("must be one of 1.0, 1.1, 1.2 or 1.3")
+
+// ErrInvalidMaxTLSVersion is an error that is returned when an input struct
+// specifies a "MaxTLSVersion" that is not a supported TLS version.
+var ErrInvalidMaxTLSVersion = NewFieldError("MaxTLSVersion").Message("must be one of 1.0, 1.1, 1.2 or 1.3")
+
+// ErrWeightWithoutAutoLoadbalance is an error that is returned when an input
+// struct sets a "Weight" for a backend that is not auto-loadbalanced.
+var ErrWeightWithoutAutoLoadbalance = NewFieldError("Weight").Message("only applies when AutoLoadbalance is set")
+
+// ErrInvalidTLSVersionRange is an error that is returned when an input struct
+// specifies a "MinTLSVersion" greater than its "MaxTLSVersion".
+var ErrInvalidTLSVersionRange = NewFieldError("MinTLSVersion").Message("must not be greater than MaxTLSVersion")
+
+// ErrInvalidMonth is an error that is returned when an input struct
+// specifies a "Month" that is not between 1 and 12.
+var ErrInvalidMonth = NewFieldError("Month").Message("must be between 1 and 12")
+
+// ErrInvalidYear is an error that is returned when an input struct specifies
+// a "Year" for which no billing data can exist.
+var ErrInvalidYear = NewFieldError("Year").Message("must be between 2011 and the current year")
+
+// ErrInvalidPackage is an error that is returned when an input struct
+// specifies a package that is not a gzipped tar archive.
+var ErrInvalidPackage = NewFieldError("Package").Message("must be a gzipped tar archive")
+
+// ErrInvalidPoolType is an error that is returned when an input struct
+// specifies a "Type" that is not a known pool type.
+var ErrInvalidPoolType = NewFieldError("Type").Message("must be one of random, hash or client")
+
+// ErrInvalidRequestSettingAction is an error that is returned when an input
+// struct specifies an "Action" key that is not a known request setting action.
+var ErrInvalidRequestSettingAction = NewFieldError("Action").Message("must be one of lookup or pass, or empty")
+
+// ErrInvalidRequestSettingXFF is an error that is returned when an input
+// struct specifies an "XForwardedFor" key that is not a known value.
+var ErrInvalidRequestSettingXFF = NewFieldError("XForwardedFor").Message("must be one of clear, leave, append, append_all or overwrite, or empty")
+
+// ErrInvalidServiceType is an error that is returned when an input struct
+// specifies a "Type" that is not a known service type.
+var ErrInvalidServiceType = NewFieldError("Type").Message("must be one of vcl or wasm")
+
+// ErrInvalidSnippetType is an error that is returned when an input struct
+// specifies a "Type" key that is not a known VCL subroutine.
+var ErrInvalidSnippetType = NewFieldError("Type").Message("must be one of init, recv, hash, hit, miss, pass, fetch, error, deliver, log or none")
+
+// ErrInvalidSnippetDynamic is an error that is returned when an input struct
+// specifies a "Dynamic" key that is neither 0 nor 1.
+var ErrInvalidSnippetDynamic = NewFieldError("Dynamic").Message("must be 0 (regular) or 1 (dynamic)")
+
+// ErrInvalidBy is an error that is returned when an input struct specifies a
+// "By" key that is not a supported sampling rate.
+var ErrInvalidBy = NewFieldError("By").Message("must be one of minute, hour or day")
+
+// ErrInvalidTokenScope is an error that is returned when an input struct
+// specifies a "Scope" key that contains an unknown token scope.
+var ErrInvalidTokenScope = NewFieldError("Scope").Message("must be one or more of global, purge_select, purge_all or global:read")
+
+// ErrInvalidDirectorType is an error that is returned when an input struct
+// specifies a "Type" key that is not a known director type.
+var ErrInvalidDirectorType = NewFieldError("Type").Message("must be one of 1 (random), 2 (round-robin), 3 (hash) or 4 (client)")
+
+// ErrInvalidQuorum is an error that is returned when an input struct
+// specifies a "Quorum" key outside the range 0 to 100.
+var ErrInvalidQuorum = NewFieldError("Quorum").Message("must be a percentage between 0 and 100")
+
+// ErrGzipLevelWithCompressionCodec is an error that is returned when an input
+// struct specifies both a "GzipLevel" and a "CompressionCodec" key.
+var ErrGzipLevelWithCompressionCodec = NewFieldError("CompressionCodec").Message("cannot be set together with GzipLevel")
+
+// ErrInvalidTLSCACert is an error that is returned when an input struct
+// specifies a "TLSCACert" key that is not PEM encoded.
+var ErrInvalidTLSCACert = NewFieldError("TLSCACert").Message("must be PEM encoded")
+
+// ErrInvalidTLSClientCert is an error that is returned when an input struct
+// specifies a "TLSClientCert" key that is not PEM encoded.
+var ErrInvalidTLSClientCert = NewFieldError("TLSClientCert").Message("must be PEM encoded")
+
+// ErrInvalidTLSClientKey is an error that is returned when an input struct
+// specifies a "TLSClientKey" key that is not PEM encoded.
+var ErrInvalidTLSClientKey = NewFieldError("TLSClientKey").Message("must be PEM encoded")
+
+// ErrKinesisCredentialsConflict is an error that is returned when an input
+// struct specifies an "IAMRole" key together with static access keys.
+var ErrKinesisCredentialsConflict = NewFieldError("IAMRole").Message("cannot be set together with AccessKey or SecretKey")
+
+// ErrInvalidRegion is an error that is returned when an input struct
+// specifies a "Region" key that the logging provider does not support.
+var ErrInvalidRegion = NewFieldError("Region").Message("is not a supported region")
+
+// ErrInvalidRequiredACKs is an error that is returned when an input struct
+// specifies a "RequiredACKs" key that is not -1, 0 or 1.
+var ErrInvalidRequiredACKs = NewFieldError("RequiredACKs").Message("must be one of -1, 0 or 1")
+
+// ErrInvalidAuthMethod is an error that is returned when an input struct
+// specifies an "AuthMethod" key that is not a supported SASL mechanism.
+var ErrInvalidAuthMethod = NewFieldError("AuthMethod").Message("must be one of plain, scram-sha-256 or scram-sha-512")
+
+// ErrInvalidFormatVersion is an error that is returned when an input struct
+// specifies a "FormatVersion" key that is neither 1 nor 2.
+var ErrInvalidFormatVersion = NewFieldError("FormatVersion").Message("must be 1 or 2")
+
+// ErrInvalidPubsubFormat is an error that is returned when an input struct
+// specifies a Pub/Sub "Format" key that is not valid JSON.
+var ErrInvalidPubsubFormat = NewFieldError("Format").Message("must be valid JSON")
+
+// ErrInvalidHTTPSMethod is an error that is returned when an input struct
+// specifies a "Method" key other than POST or PUT for an HTTPS logging
+// endpoint.
+var ErrInvalidHTTPSMethod = NewFieldError("Method").Message("must be POST or PUT")
+
+// ErrInvalidJSONFormat is an error that is returned when an input struct
+// specifies a "JSONFormat" key that is not 0, 1 or 2.
+var ErrInvalidJSONFormat = NewFieldError("JSONFormat").Message("must be one of 0, 1 or 2")
+
+// ErrInvalidMessageType is an error that is returned when an input struct
+// specifies a "MessageType" key that is not a known log message type.
+var ErrInvalidMessageType = NewFieldError("MessageType").Message("must be one of classic, loggly, logplex or blank")
+
+// ErrInvalidMethod is an error that is returned when an input struct
+// specifies a "Method" key that is not a supported HTTP method.
+var ErrInvalidMethod = NewFieldError("Method").Message("must be one of GET, HEAD, POST, PUT, PATCH, DELETE or OPTIONS")
+
+// ErrInvalidExpectedResponse is an error that is returned when an input struct
+// specifies an "ExpectedResponse" key that is not a valid HTTP status code.
+var ErrInvalidExpectedResponse = NewFieldError("ExpectedResponse").Message("must be an HTTP status code between 100 and 599")
+
+// ErrInvalidStatus is an error that is returned when an input struct
+// specifies a "Status" key that is not a valid HTTP status code.
+var ErrInvalidStatus = NewFieldError("Status").Message("must be an HTTP status code between 100 and 599")
+
+// ErrInvalidWAFActiveRuleStatus is an error that is returned when an input
+// struct specifies a WAF active rule "Status" that is not log, block or score.
+var ErrInvalidWAFActiveRuleStatus = NewFieldError("Status").Message("must be one of log, block or score")
+
+// ErrInvalidCertificateAuthority is an error that is returned when an input
+// struct specifies a "CertificateAuthority" that is not supported.
+var ErrInvalidCertificateAuthority = NewFieldError("CertificateAuthority").Message("must be one of lets-encrypt, globalsign or certainly")
+
+// ErrInvalidDiffFormat is an error that is returned when an input struct
+// specifies a "Format" that is not a known diff format.
+var ErrInvalidDiffFormat = NewFieldError("Format").Message("must be one of text, html or html_simple")
+
+// ErrInvalidKey is an error that is returned when an input struct specifies a
+// "Key" that is not a PEM-encoded RSA or EC private key. The key material is
+// deliberately not included in the message.
+var ErrInvalidKey = NewFieldError("Key").Message("must be a PEM-encoded RSA or EC private key")
+
+// ErrUnknownPrefetchCondition is an error that is returned when an input
+// struct specifies a "PrefetchCondition" that does not exist on the service
+// version.
+var ErrUnknownPrefetchCondition = NewFieldError("PrefetchCondition").Message("does not name an existing condition on the service version")
+
+// ErrUnknownResponse is an error that is returned when an input struct
+// specifies a "Response" that does not exist on the service version.
+var ErrUnknownResponse = NewFieldError("Response").Message("does not name an existing response object on the service version")
+
 // ErrMissingCustomerID is an error that is returned when an input struct
 // requires a "CustomerID" key, but one was not set.
 var ErrMissingCustomerID = NewFieldError("CustomerID")
 
+// ErrMissingDestination is an error that is returned when an input struct
+// requires a "Destination" key, but one was not set.
+var ErrMissingDestination = NewFieldError("Destination")
+
 // ErrMissingDictionaryID is an error that is returned when an input struct
 // requires a "DictionaryID" key, but one was not set.
 var ErrMissingDictionaryID = NewFieldError("DictionaryID")
@@ -95,10 +289,18 @@ var ErrMissingDictionaryID = NewFieldError("DictionaryID")
 // requires a "Director" key, but one was not set.
 var ErrMissingDirector = NewFieldError("Director")
 
+// ErrMissingDomain is an error that is returned when an input struct
+// requires a "Domain" key, but one was not set.
+var ErrMissingDomain = NewFieldError("Domain")
+
 // ErrMissingEventID is an error that is returned when an input struct
 // requires a "EventID" key, but one was not set.
 var ErrMissingEventID = NewFieldError("EventID")
 
+// ErrMissingExport is an error that is returned when an input struct
+// requires a "Export" key, but one was not set.
+var ErrMissingExport = NewFieldError("Export")
+
 // ErrMissingFrom is an error that is returned when an input struct
 // requires a "From" key, but one was not set.
 var ErrMissingFrom = NewFieldError("From")
@@ -115,6 +317,10 @@ var ErrMissingID = NewFieldError("ID")
 // requires a "IP" key, but one was not set.
 var ErrMissingIP = NewFieldError("IP")
 
+// ErrMissingHealthCheck is an error that is returned when an input struct
+// requires a "HealthCheck" key, but one was not set.
+var ErrMissingHealthCheck = NewFieldError("HealthCheck")
+
 // ErrMissingIntermediatesBlob is an error that is returned when an input struct
 // requires a "IntermediatesBlob" key, but one was not set.
 var ErrMissingIntermediatesBlob = NewFieldError("IntermediatesBlob")
@@ -123,6 +329,10 @@ var ErrMissingIntermediatesBlob = NewFieldError("IntermediatesBlob")
 // requires a "ItemKey" key, but one was not set.
 var ErrMissingItemKey = NewFieldError("ItemKey")
 
+// ErrMissingKinesisCredentials is an error that is returned when an input
+// struct provides neither an "IAMRole" nor both "AccessKey" and "SecretKey".
+var ErrMissingKinesisCredentials = NewFieldError("IAMRole").Message("is required unless both AccessKey and SecretKey are set")
+
 // ErrMissingKey is an error that is returned when an input struct
 // requires a "Key" key, but one was not set.
 var ErrMissingKey = NewFieldError("Key")
@@ -147,6 +357,10 @@ var ErrMissingName = NewFieldError("Name")
 // requires a "Name" key, but one was not set.
 var ErrMissingNameValue = NewFieldError("Name").Message("service name can't be an empty value")
 
+// ErrMissingNames is an error that is returned when an input struct
+// requires a "Names" key, but one was not set.
+var ErrMissingNames = NewFieldError("Names")
+
 // ErrMissingNewName is an error that is returned when an input struct
 // requires a "NewName" key, but one was not set.
 var ErrMissingNewName = NewFieldError("NewName")
@@ -159,6 +373,19 @@ var ErrMissingNumber = NewFieldError("Number")
 // requires a "PoolID" key, but one was not set.
 var ErrMissingPoolID = NewFieldError("PoolID")
 
+// ErrMissingPackage is an error that is returned when an input struct sets
+// neither a "PackagePath" nor a "Package" key.
+var ErrMissingPackage = NewFieldError("Package").Message("is required when PackagePath is not set")
+
+// ErrMissingPasswordOrSecretKey is an error that is returned when an input
+// struct requires either a "Password" or a "SecretKey" key, but neither was
+// set.
+var ErrMissingPasswordOrSecretKey = NewFieldError("Password").Message("is required when SecretKey is not set")
+
+// ErrMissingResponse is an error that is returned when an input struct
+// requires a "Response" key, but one was not set.
+var ErrMissingResponse = NewFieldError("Response")
+
 // ErrMissingServer is an error that is returned when an input struct
 // requires a "Server" key, but one was not set.
 var ErrMissingServer = NewFieldError("Server")
@@ -167,6 +394,10 @@ var ErrMissingServer = NewFieldError("Server")
 // input struct requires a "ServerSideEncryptionKMSKeyID" key, but one was not set.
 var ErrMissingServerSideEncryptionKMSKeyID = NewFieldError("ServerSideEncryptionKMSKeyID")
 
+// ErrMissingSSHKnownHosts is an error that is returned when an input struct
+// requires a "SSHKnownHosts" key, but one was not set.
+var ErrMissingSSHKnownHosts = NewFieldError("SSHKnownHosts")
+
 // ErrMissingServiceID is an error that is returned when an input struct
 // requires a "ServiceID" key, but one was not set.
 var ErrMissingServiceID = NewFieldError("ServiceID")
@@ -199,6 +430,10 @@ var ErrMissingTo = NewFieldError("To")
 // "Kind" key, but one was not set.
 var ErrMissingKind = NewFieldError("Kind")
 
+// ErrMissingModSecID is an error that is returned when an input struct
+// requires a "ModSecID" key, but one was not set.
+var ErrMissingModSecID = NewFieldError("ModSecID")
+
 // ErrMissingURL is an error that is returned when an input struct
 // requires a "URL" key, but one was not set.
 var ErrMissingURL = NewFieldError("URL")
@@ -235,6 +470,10 @@ var ErrMissingYear = NewFieldError("Year")
 // struct requires either a "Name" or "Comment" key, but one was not set.
 var ErrMissingOptionalNameComment = NewFieldError("Name, Comment").Message("at least one of the available 'optional' fields is required")
 
+// ErrMissingOptionalStatusRevision is an error that is returned when an input
+// struct requires either a "Status" or "Revision" key, but one was not set.
+var ErrMissingOptionalStatusRevision = NewFieldError("Status, Revision").Message("at least one of the available 'optional' fields is required")
+
 // ErrMissingTokensValue is an error that is returned when an input struct
 // requires a "Tokens" key, but there needs to be at least one token entry.
 var ErrMissingTokensValue = NewFieldError("Tokens").Message("expect at least one token")
@@ -249,6 +488,42 @@ var ErrNotOK = errors.New("not ok")
 // ErrNotImplemented is a generic error indicating that something is not yet implemented.
 var ErrNotImplemented = errors.New("not implemented")
 
+// ErrNoMainVCL is an error that indicates that none of a version's VCLs is
+// marked as main.
+var ErrNoMainVCL = errors.New("version has no main VCL")
+
+// ErrDryRun is an error that indicates that an operation needs the result of
+// an earlier request to continue, which is not available in DryRun mode.
+var ErrDryRun = errors.New("result of an earlier request is not available in dry-run mode")
+
+// ErrTooManyPages is an error that indicates that a list endpoint kept
+// linking to further pages beyond the number the client is willing to fetch.
+var ErrTooManyPages = errors.New("too many pages in list response")
+
+// ErrNoActiveVersion is an error that indicates that a service has no active
+// version.
+var ErrNoActiveVersion = errors.New("service has no active version")
+
+// ErrNoVersions is an error that indicates that a service has no versions.
+var ErrNoVersions = errors.New("service has no versions")
+
+// ErrNoActiveWAFVersion is an error that indicates that a WAF has no active
+// version.
+var ErrNoActiveWAFVersion = errors.New("WAF has no active version")
+
+// ErrServiceNotFound is an error that indicates that SearchService found no
+// service with the requested name.
+var ErrServiceNotFound = errors.New("service not found")
+
+// ErrServiceActive is an error that indicates that a service could not be
+// deleted because it has an active version, which must be deactivated first.
+// DeleteService returns it wrapped in a *ServiceActiveError.
+var ErrServiceActive = errors.New("service has an active version; deactivate it before deleting")
+
+// ErrWAFDeploymentFailed is an error that indicates that a WAF version
+// deployment finished with a failed status.
+var ErrWAFDeploymentFailed = errors.New("WAF version deployment failed")
+
 // ErrManagedLoggingEnabled is an error that indicates that managed logging was
 // already enabled for a service.
 var ErrManagedLoggingEnabled = errors.New("managed logging already enabled")
@@ -262,25 +537,34 @@ type HTTPError struct {
 	// StatusCode is the HTTP status code (2xx-5xx).
 	StatusCode int
 
-	Errors []*ErrorObject `mapstructure:"errors"`
+	// RateLimitRemaining is the value of the Fastly-RateLimit-Remaining
+	// response header. It is zero when the header was not returned.
+	RateLimitRemaining int
+
+	// RateLimitReset is the time at which the current rate limit window
+	// resets, as reported by the Fastly-RateLimit-Reset response header. It is
+	// the zero time when the header was not returned.
+	RateLimitReset time.Time
+
+	Errors []*ErrorObject `mapstructure:"errors" json:"errors"`
 }
 
 // ErrorObject is a single error.
 type ErrorObject struct {
-	ID     string `mapstructure:"id"`
-	Title  string `mapstructure:"title"`
-	Detail string `mapstructure:"detail"`
-	Status string `mapstructure:"status"`
-	Code   string `mapstructure:"code"`
+	ID     string `mapstructure:"id" json:"id"`
+	Title  string `mapstructure:"title" json:"title"`
+	Detail string `mapstructure:"detail" json:"detail"`
+	Status string `mapstructure:"status" json:"status"`
+	Code   string `mapstructure:"code" json:"code"`
 
-	Meta *map[string]interface{} `mapstructure:"meta"`
+	Meta *map[string]interface{} `mapstructure:"meta" json:"meta"`
 }
 
 // legacyError represents the older-style errors from Fastly. It is private
 // because it is automatically converted to a jsonapi error.
 type legacyError struct {
-	Message string `mapstructure:"msg"`
-	Detail  string `mapstructure:"detail"`
+	Message string `mapstructure:"msg" json:"msg"`
+	Detail  string `mapstructure:"detail" json:"detail"`
 }
 
 // NewHTTPError creates a new HTTP error from the given code.
@@ -288,6 +572,13 @@ func NewHTTPError(resp *http.Response) *HTTPError {
 	var e HTTPError
 	e.StatusCode = resp.StatusCode
 
+	if n, ok := parseRateLimitRemaining(resp.Header); ok {
+		e.RateLimitRemaining = n
+	}
+	if t, ok := parseRateLimitReset(resp.Header); ok {
+		e.RateLimitReset = t
+	}
+
 	if resp.Body == nil {
 		return &e
 	}
@@ -355,3 +646,197 @@ func (e *HTTPError) String() string {
 func (e *HTTPError) IsNotFound() bool {
 	return e.StatusCode == 404
 }
+
+// IsBadRequest returns true if the HTTP error code is a 400, false otherwise.
+//
+// NOTE: some endpoints, such as GET /service/:service_id, respond with a 400
+// rather than a 404 when the requested resource does not exist.
+func (e *HTTPError) IsBadRequest() bool {
+	return e.StatusCode == 400
+}
+
+// mentions reports whether any of the error's titles or details contain s,
+// ignoring case.
+func (e *HTTPError) mentions(s string) bool {
+	s = strings.ToLower(s)
+	for _, eo := range e.Errors {
+		if strings.Contains(strings.ToLower(eo.Title), s) || strings.Contains(strings.ToLower(eo.Detail), s) {
+			return true
+		}
+	}
+	return false
+}
+
+// isRecordNotFound reports whether the error says the requested record does
+// not exist: either a 404, or a 400 with the API's "Record not found" message.
+func (e *HTTPError) isRecordNotFound() bool {
+	return e.IsNotFound() || e.IsBadRequest() && e.mentions("record not found")
+}
+
+// isConflict reports whether the error says the object being created already
+// exists. Depending on the endpoint, the API reports this either as a 409 or
+// as a 400 naming the duplicate.
+func (e *HTTPError) isConflict() bool {
+	return e.StatusCode == http.StatusConflict ||
+		(e.IsBadRequest() && (e.mentions("already exists") || e.mentions("duplicate")))
+}
+
+// Ensure MultiError is, in fact, an error.
+var _ error = (*MultiError)(nil)
+
+// MultiError collects the errors of an operation that was performed for
+// several objects, keyed by the ID or name of the object that failed.
+type MultiError struct {
+	Errors map[string]error
+}
+
+// Error implements the error interface and lists each failure in key order.
+func (e *MultiError) Error() string {
+	ids := make([]string, 0, len(e.Errors))
+	for id := range e.Errors {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "%d operation(s) failed:", len(ids))
+	for _, id := range ids {
+		fmt.Fprintf(&b, "\n  %s: %s", id, e.Errors[id])
+	}
+	return b.String()
+}
+
+// Ensure ServiceTypeError is, in fact, an error.
+var _ error = (*ServiceTypeError)(nil)
+
+// ServiceTypeError is returned, when service type checks are enabled, by
+// operations that do not apply to the type of the target service, such as
+// creating VCL on a Compute@Edge (wasm) service.
+type ServiceTypeError struct {
+	ServiceID string
+	Type      string
+	Required  string
+}
+
+// Error implements the error interface.
+func (e *ServiceTypeError) Error() string {
+	return fmt.Sprintf("service %s is a %s service, but this operation requires a %s service", e.ServiceID, e.Type, e.Required)
+}
+
+// Ensure ServiceActiveError is, in fact, an error.
+var _ error = (*ServiceActiveError)(nil)
+
+// ServiceActiveError is returned by DeleteService when a service could not be
+// deleted because it has an active version.
+type ServiceActiveError struct {
+	ServiceID      string
+	ServiceVersion int
+
+	// Err is the error returned by the API.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *ServiceActiveError) Error() string {
+	return fmt.Sprintf("service %s has active version %d; deactivate it before deleting: %s", e.ServiceID, e.ServiceVersion, e.Err)
+}
+
+// Is reports whether target is ErrServiceActive.
+func (e *ServiceActiveError) Is(target error) bool {
+	return target == ErrServiceActive
+}
+
+// Unwrap returns the error returned by the API.
+func (e *ServiceActiveError) Unwrap() error {
+	return e.Err
+}
+
+// Ensure ConditionError is, in fact, an error.
+var _ error = (*ConditionError)(nil)
+
+// ConditionError is returned, when condition checks are enabled, by
+// operations whose input references a condition that does not exist on the
+// version or is of the wrong type.
+type ConditionError struct {
+	ServiceID      string
+	ServiceVersion int
+
+	// Field is the input field holding the reference, such as
+	// "RequestCondition", and Name is the condition it names.
+	Field string
+	Name  string
+
+	// Type is the type of the condition, or empty if it does not exist, and
+	// Required is the type the field requires.
+	Type     ConditionType
+	Required ConditionType
+}
+
+// Error implements the error interface.
+func (e *ConditionError) Error() string {
+	if e.Type == "" {
+		return fmt.Sprintf("%s %q does not exist on version %d of service %s", e.Field, e.Name, e.ServiceVersion, e.ServiceID)
+	}
+	return fmt.Sprintf("%s %q is a %s condition, but must be a %s condition", e.Field, e.Name, e.Type, e.Required)
+}
+
+// Ensure ImportError is, in fact, an error.
+var _ error = (*ImportError)(nil)
+
+// ImportError is returned by ImportVersion when an object of the export could
+// not be created on the new version.
+type ImportError struct {
+	ServiceID      string
+	ServiceVersion int
+
+	// Resource is the kind of object, such as "backend", and Name is its
+	// name. Name is empty for the version settings.
+	Resource string
+	Name     string
+
+	// Err is the error returned when creating the object.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *ImportError) Error() string {
+	if e.Name == "" {
+		return fmt.Sprintf("importing %s into version %d of service %s: %s", e.Resource, e.ServiceVersion, e.ServiceID, e.Err)
+	}
+	return fmt.Sprintf("importing %s %q into version %d of service %s: %s", e.Resource, e.Name, e.ServiceVersion, e.ServiceID, e.Err)
+}
+
+// Unwrap returns the error returned when creating the object.
+func (e *ImportError) Unwrap() error {
+	return e.Err
+}
+
+// Ensure ValidationError is, in fact, an error.
+var _ error = (*ValidationError)(nil)
+
+// ValidationError is returned by CheckVersion when a service version fails
+// validation.
+type ValidationError struct {
+	ServiceID      string
+	ServiceVersion int
+
+	// Status is the validation status reported by the API, such as "error".
+	Status string
+
+	// Message is the API's validation message or, when it gave none, the
+	// individual errors joined by newlines.
+	Message string
+
+	// Errors and Warnings are the individual problems reported by the API,
+	// such as a backend referencing a missing health check.
+	Errors   []string
+	Warnings []string
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("version %d of service %s is not valid", e.ServiceVersion, e.ServiceID)
+	}
+	return fmt.Sprintf("version %d of service %s is not valid: %s", e.ServiceVersion, e.ServiceID, e.Message)
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/jsonapi"
 )
@@ -74,4 +75,32 @@ func TestNewHTTPError(t *testing.T) {
 			t.Error("not not found")
 		}
 	})
+
+	t.Run("rate_limit", func(t *testing.T) {
+		resp := &http.Response{
+			StatusCode: 400,
+			Header:     http.Header{},
+			Body: ioutil.NopCloser(bytes.NewBufferString(
+				`{"msg": "Record not found", "detail": "Couldn't find Service"}`)),
+		}
+		resp.Header.Set(RateLimitRemainingHeader, "42")
+		resp.Header.Set(RateLimitResetHeader, "1452032384")
+		e := NewHTTPError(resp)
+
+		if e.RateLimitRemaining != 42 {
+			t.Errorf("expected %d to be %d", e.RateLimitRemaining, 42)
+		}
+
+		if !e.RateLimitReset.Equal(time.Unix(1452032384, 0)) {
+			t.Errorf("bad rate limit reset: %s", e.RateLimitReset)
+		}
+
+		if !e.IsBadRequest() {
+			t.Error("not bad request")
+		}
+
+		if e.IsNotFound() {
+			t.Error("expected not to be not found")
+		}
+	})
 }
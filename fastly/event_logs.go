@@ -42,6 +42,14 @@ type GetAPIEventsFilterInput struct {
 	// UserID to Limit the returned events to a specific user.
 	UserID string
 
+	// CreatedAfter limits the returned events to those created at or after
+	// this date (e.g. "2021-06-01" or "2021-06-01T00:00:00Z").
+	CreatedAfter string
+
+	// CreatedBefore limits the returned events to those created at or before
+	// this date (e.g. "2021-06-30" or "2021-06-30T23:59:59Z").
+	CreatedBefore string
+
 	// Number is the Pagination page number.
 	PageNumber int
 
@@ -151,7 +159,7 @@ func (c *Client) interpretAPIEventsPage(answer *GetAPIEventsResponse, pageNum in
 			if err != nil {
 				return err
 			}
-			c.interpretAPIEventsPage(answer, pageNum, resp)
+			return c.interpretAPIEventsPage(answer, pageNum, resp)
 		}
 		return nil
 	}
@@ -180,12 +188,14 @@ func getEventsPages(body io.Reader) (EventsPaginationInfo, io.Reader, error) {
 func (i *GetAPIEventsFilterInput) formatEventFilters() map[string]string {
 	result := map[string]string{}
 	pairings := map[string]interface{}{
-		"filter[customer_id]": i.CustomerID,
-		"filter[service_id]":  i.ServiceID,
-		"filter[event_type]":  i.EventType,
-		"filter[user_id]":     i.UserID,
-		"page[size]":          i.MaxResults,
-		"page[number]":        i.PageNumber, // starts at 1, not 0
+		"filter[customer_id]":     i.CustomerID,
+		"filter[service_id]":      i.ServiceID,
+		"filter[event_type]":      i.EventType,
+		"filter[user_id]":         i.UserID,
+		"filter[created_at][gte]": i.CreatedAfter,
+		"filter[created_at][lte]": i.CreatedBefore,
+		"page[size]":              i.MaxResults,
+		"page[number]":            i.PageNumber, // starts at 1, not 0
 	}
 	// NOTE: This setup means we will not be able to send the zero value
 	// of any of these filters. It doesn't appear we would need to at present.
@@ -75,6 +75,19 @@ func TestGetAPIEventsFilterInput_formatFilters(t *testing.T) {
 				"page[number]":        "2",
 			},
 		},
+		{
+			description: "adds the created_at date range",
+			filters: GetAPIEventsFilterInput{
+				ServiceID:     "5343548168357658",
+				CreatedAfter:  "2021-06-01",
+				CreatedBefore: "2021-06-30T23:59:59Z",
+			},
+			expected: map[string]string{
+				"filter[service_id]":      "5343548168357658",
+				"filter[created_at][gte]": "2021-06-01",
+				"filter[created_at][lte]": "2021-06-30T23:59:59Z",
+			},
+		},
 	}
 	for _, testcase := range tests {
 		answer := testcase.filters.formatEventFilters()
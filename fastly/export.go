@@ -0,0 +1,458 @@
+package fastly
+
+import (
+	"sync"
+)
+
+// ExportVersionConcurrency is the number of requests ExportVersion has in
+// flight at once.
+const ExportVersionConcurrency = 4
+
+// VersionExport is a snapshot of the configuration of a service version, as
+// returned by ExportVersion.
+type VersionExport struct {
+	ServiceID      string `json:"service_id"`
+	ServiceVersion int    `json:"version"`
+
+	Settings        *Settings         `json:"settings"`
+	Domains         []*Domain         `json:"domains"`
+	Conditions      []*Condition      `json:"conditions"`
+	HealthChecks    []*HealthCheck    `json:"healthchecks"`
+	Backends        []*Backend        `json:"backends"`
+	Directors       []*Director       `json:"directors"`
+	Headers         []*Header         `json:"headers"`
+	CacheSettings   []*CacheSetting   `json:"cache_settings"`
+	RequestSettings []*RequestSetting `json:"request_settings"`
+	ResponseObjects []*ResponseObject `json:"response_objects"`
+	VCLs            []*VCL            `json:"vcls"`
+	Snippets        []*Snippet        `json:"snippets"`
+	ACLs            []*ACL            `json:"acls"`
+	Dictionaries    []*Dictionary     `json:"dictionaries"`
+}
+
+// ExportVersionInput is used as input to the ExportVersion function.
+type ExportVersionInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// ServiceVersion is the specific configuration version (required).
+	ServiceVersion int
+}
+
+// ExportVersion fetches the settings and every kind of object configured on
+// a version, up to ExportVersionConcurrency requests at a time. The entries
+// of ACLs and the items of dictionaries are not versioned and are not
+// included.
+//
+// A failed fetch does not stop the others: the export is returned with the
+// objects that were fetched, alongside a *MultiError keyed by the JSON name
+// of each field that could not be filled, such as "backends".
+func (c *Client) ExportVersion(i *ExportVersionInput) (*VersionExport, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	if i.ServiceVersion == 0 {
+		return nil, ErrMissingServiceVersion
+	}
+
+	id, v := i.ServiceID, i.ServiceVersion
+	e := &VersionExport{ServiceID: id, ServiceVersion: v}
+	fetches := map[string]func() error{
+		"settings": func() (err error) {
+			e.Settings, err = c.GetSettings(&GetSettingsInput{ServiceID: id, ServiceVersion: v})
+			return err
+		},
+		"domains": func() (err error) {
+			e.Domains, err = c.ListDomains(&ListDomainsInput{ServiceID: id, ServiceVersion: v})
+			return err
+		},
+		"conditions": func() (err error) {
+			e.Conditions, err = c.ListConditions(&ListConditionsInput{ServiceID: id, ServiceVersion: v})
+			return err
+		},
+		"healthchecks": func() (err error) {
+			e.HealthChecks, err = c.ListHealthChecks(&ListHealthChecksInput{ServiceID: id, ServiceVersion: v})
+			return err
+		},
+		"backends": func() (err error) {
+			e.Backends, err = c.ListBackends(&ListBackendsInput{ServiceID: id, ServiceVersion: v})
+			return err
+		},
+		"directors": func() (err error) {
+			e.Directors, err = c.ListDirectors(&ListDirectorsInput{ServiceID: id, ServiceVersion: v})
+			return err
+		},
+		"headers": func() (err error) {
+			e.Headers, err = c.ListHeaders(&ListHeadersInput{ServiceID: id, ServiceVersion: v})
+			return err
+		},
+		"cache_settings": func() (err error) {
+			e.CacheSettings, err = c.ListCacheSettings(&ListCacheSettingsInput{ServiceID: id, ServiceVersion: v})
+			return err
+		},
+		"request_settings": func() (err error) {
+			e.RequestSettings, err = c.ListRequestSettings(&ListRequestSettingsInput{ServiceID: id, ServiceVersion: v})
+			return err
+		},
+		"response_objects": func() (err error) {
+			e.ResponseObjects, err = c.ListResponseObjects(&ListResponseObjectsInput{ServiceID: id, ServiceVersion: v})
+			return err
+		},
+		"vcls": func() (err error) {
+			e.VCLs, err = c.ListVCLs(&ListVCLsInput{ServiceID: id, ServiceVersion: v})
+			return err
+		},
+		"snippets": func() (err error) {
+			e.Snippets, err = c.ListSnippets(&ListSnippetsInput{ServiceID: id, ServiceVersion: v})
+			return err
+		},
+		"acls": func() (err error) {
+			e.ACLs, err = c.ListACLs(&ListACLsInput{ServiceID: id, ServiceVersion: v})
+			return err
+		},
+		"dictionaries": func() (err error) {
+			e.Dictionaries, err = c.ListDictionaries(&ListDictionariesInput{ServiceID: id, ServiceVersion: v})
+			return err
+		},
+	}
+
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		merr = &MultiError{Errors: map[string]error{}}
+	)
+	sem := make(chan struct{}, ExportVersionConcurrency)
+	for name, fetch := range fetches {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(name string, fetch func() error) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := fetch(); err != nil {
+				mu.Lock()
+				merr.Errors[name] = err
+				mu.Unlock()
+			}
+		}(name, fetch)
+	}
+	wg.Wait()
+
+	if len(merr.Errors) > 0 {
+		return e, merr
+	}
+	return e, nil
+}
+
+// ImportVersionInput is used as input to the ImportVersion function.
+type ImportVersionInput struct {
+	// ServiceID is the ID of the service to import into (required).
+	ServiceID string
+
+	// Export is the configuration to import, as returned by ExportVersion
+	// (required). It may come from another service.
+	Export *VersionExport
+
+	// Comment is a personal, freeform descriptive note for the new version.
+	Comment string
+}
+
+// importStep creates one object of an export on the new version.
+type importStep struct {
+	resource string
+	name     string
+	create   func() error
+}
+
+// ImportVersion creates a new, blank version of the service and recreates on
+// it every object of the export, returning the number of the new version.
+// The version starts blank rather than cloned so that the objects of the
+// export do not collide with those already on the service.
+//
+// Objects are created one at a time, conditions and health checks first,
+// then the backends, directors and other objects that reference them. IDs,
+// timestamps and the other fields set by the API are not sent.
+//
+// The new version is never activated. If an object cannot be created, the
+// import stops and an *ImportError naming the object is returned along with
+// the number of the incomplete version, so that it can be inspected or
+// discarded.
+func (c *Client) ImportVersion(i *ImportVersionInput) (int, error) {
+	if i.ServiceID == "" {
+		return 0, ErrMissingServiceID
+	}
+
+	if i.Export == nil {
+		return 0, ErrMissingExport
+	}
+
+	version, err := c.CreateVersion(&CreateVersionInput{
+		ServiceID: i.ServiceID,
+		Comment:   i.Comment,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if c.DryRun {
+		return 0, ErrDryRun
+	}
+
+	id, v := i.ServiceID, version.Number
+	for _, step := range importSteps(c, id, v, i.Export) {
+		if err := step.create(); err != nil {
+			return v, &ImportError{
+				ServiceID:      id,
+				ServiceVersion: v,
+				Resource:       step.resource,
+				Name:           step.name,
+				Err:            err,
+			}
+		}
+	}
+	return v, nil
+}
+
+// importSteps returns the steps that recreate the objects of e on version v
+// of service id, in dependency order.
+func importSteps(c *Client, id string, v int, e *VersionExport) []importStep {
+	var steps []importStep
+	add := func(resource, name string, create func() error) {
+		steps = append(steps, importStep{resource: resource, name: name, create: create})
+	}
+
+	if s := e.Settings; s != nil {
+		add("settings", "", func() error {
+			_, err := c.UpdateSettings(&UpdateSettingsInput{
+				ServiceID:       id,
+				ServiceVersion:  v,
+				DefaultTTL:      Uint(s.DefaultTTL),
+				DefaultHost:     String(s.DefaultHost),
+				StaleIfError:    Bool(s.StaleIfError),
+				StaleIfErrorTTL: Uint(s.StaleIfErrorTTL),
+			})
+			return err
+		})
+	}
+	for _, o := range e.Conditions {
+		o := o
+		add("condition", o.Name, func() error {
+			_, err := c.CreateCondition(&CreateConditionInput{
+				ServiceID:      id,
+				ServiceVersion: v,
+				Name:           o.Name,
+				Statement:      o.Statement,
+				Type:           o.Type,
+				Priority:       Int(o.Priority),
+			})
+			return err
+		})
+	}
+	for _, o := range e.HealthChecks {
+		o := o
+		add("healthcheck", o.Name, func() error {
+			// Zero is not a status code; leave it to the API default.
+			var expected *uint
+			if o.ExpectedResponse != 0 {
+				expected = Uint(o.ExpectedResponse)
+			}
+			_, err := c.CreateHealthCheck(&CreateHealthCheckInput{
+				ServiceID:        id,
+				ServiceVersion:   v,
+				Name:             o.Name,
+				Comment:          o.Comment,
+				Method:           o.Method,
+				Host:             o.Host,
+				Path:             o.Path,
+				HTTPVersion:      o.HTTPVersion,
+				Timeout:          Uint(o.Timeout),
+				CheckInterval:    Uint(o.CheckInterval),
+				ExpectedResponse: expected,
+				Window:           Uint(o.Window),
+				Threshold:        Uint(o.Threshold),
+				Initial:          Uint(o.Initial),
+			})
+			return err
+		})
+	}
+	for _, o := range e.Backends {
+		o := o
+		add("backend", o.Name, func() error {
+			ci := backendCreateInput(o)
+			ci.ServiceID = id
+			ci.ServiceVersion = v
+			_, err := c.CreateBackend(ci)
+			return err
+		})
+	}
+	for _, o := range e.Directors {
+		o := o
+		add("director", o.Name, func() error {
+			_, err := c.CreateDirector(&CreateDirectorInput{
+				ServiceID:      id,
+				ServiceVersion: v,
+				Name:           o.Name,
+				Comment:        o.Comment,
+				Shield:         o.Shield,
+				Quorum:         Uint(o.Quorum),
+				Type:           o.Type,
+				Retries:        Uint(o.Retries),
+				Capacity:       Uint(o.Capacity),
+			})
+			return err
+		})
+		for _, b := range o.Backends {
+			b := b
+			add("director_backend", o.Name+"/"+b, func() error {
+				_, err := c.CreateDirectorBackend(&CreateDirectorBackendInput{
+					ServiceID:      id,
+					ServiceVersion: v,
+					Director:       o.Name,
+					Backend:        b,
+				})
+				return err
+			})
+		}
+	}
+	for _, o := range e.Domains {
+		o := o
+		add("domain", o.Name, func() error {
+			_, err := c.CreateDomain(&CreateDomainInput{
+				ServiceID:      id,
+				ServiceVersion: v,
+				Name:           o.Name,
+				Comment:        o.Comment,
+			})
+			return err
+		})
+	}
+	for _, o := range e.Headers {
+		o := o
+		add("header", o.Name, func() error {
+			_, err := c.CreateHeader(&CreateHeaderInput{
+				ServiceID:         id,
+				ServiceVersion:    v,
+				Name:              o.Name,
+				Action:            o.Action,
+				IgnoreIfSet:       Compatibool(o.IgnoreIfSet),
+				Type:              o.Type,
+				Destination:       o.Destination,
+				Source:            o.Source,
+				Regex:             o.Regex,
+				Substitution:      o.Substitution,
+				Priority:          Uint(o.Priority),
+				RequestCondition:  o.RequestCondition,
+				CacheCondition:    o.CacheCondition,
+				ResponseCondition: o.ResponseCondition,
+			})
+			return err
+		})
+	}
+	for _, o := range e.CacheSettings {
+		o := o
+		add("cache_setting", o.Name, func() error {
+			_, err := c.CreateCacheSetting(&CreateCacheSettingInput{
+				ServiceID:      id,
+				ServiceVersion: v,
+				Name:           o.Name,
+				Action:         o.Action,
+				TTL:            o.TTL,
+				StaleTTL:       o.StaleTTL,
+				CacheCondition: o.CacheCondition,
+			})
+			return err
+		})
+	}
+	for _, o := range e.RequestSettings {
+		o := o
+		add("request_setting", o.Name, func() error {
+			_, err := c.CreateRequestSetting(&CreateRequestSettingInput{
+				ServiceID:        id,
+				ServiceVersion:   v,
+				Name:             o.Name,
+				ForceMiss:        Compatibool(o.ForceMiss),
+				ForceSSL:         Compatibool(o.ForceSSL),
+				Action:           o.Action,
+				BypassBusyWait:   Compatibool(o.BypassBusyWait),
+				MaxStaleAge:      Uint(o.MaxStaleAge),
+				HashKeys:         o.HashKeys,
+				XForwardedFor:    o.XForwardedFor,
+				TimerSupport:     Compatibool(o.TimerSupport),
+				GeoHeaders:       Compatibool(o.GeoHeaders),
+				DefaultHost:      o.DefaultHost,
+				RequestCondition: o.RequestCondition,
+			})
+			return err
+		})
+	}
+	for _, o := range e.ResponseObjects {
+		o := o
+		add("response_object", o.Name, func() error {
+			_, err := c.CreateResponseObject(&CreateResponseObjectInput{
+				ServiceID:        id,
+				ServiceVersion:   v,
+				Name:             o.Name,
+				Status:           Uint(o.Status),
+				Response:         o.Response,
+				Content:          o.Content,
+				ContentType:      o.ContentType,
+				RequestCondition: o.RequestCondition,
+				CacheCondition:   o.CacheCondition,
+			})
+			return err
+		})
+	}
+	for _, o := range e.VCLs {
+		o := o
+		add("vcl", o.Name, func() error {
+			_, err := c.CreateVCL(&CreateVCLInput{
+				ServiceID:      id,
+				ServiceVersion: v,
+				Name:           o.Name,
+				Content:        o.Content,
+				Main:           o.Main,
+			})
+			return err
+		})
+	}
+	for _, o := range e.Snippets {
+		o := o
+		add("snippet", o.Name, func() error {
+			_, err := c.CreateSnippet(&CreateSnippetInput{
+				ServiceID:      id,
+				ServiceVersion: v,
+				Name:           o.Name,
+				Priority:       Int(o.Priority),
+				Dynamic:        o.Dynamic,
+				Content:        o.Content,
+				Type:           o.Type,
+			})
+			return err
+		})
+	}
+	for _, o := range e.ACLs {
+		o := o
+		add("acl", o.Name, func() error {
+			_, err := c.CreateACL(&CreateACLInput{
+				ServiceID:      id,
+				ServiceVersion: v,
+				Name:           o.Name,
+			})
+			return err
+		})
+	}
+	for _, o := range e.Dictionaries {
+		o := o
+		add("dictionary", o.Name, func() error {
+			_, err := c.CreateDictionary(&CreateDictionaryInput{
+				ServiceID:      id,
+				ServiceVersion: v,
+				Name:           o.Name,
+				WriteOnly:      Compatibool(o.WriteOnly),
+			})
+			return err
+		})
+	}
+	return steps
+}
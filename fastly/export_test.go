@@ -0,0 +1,132 @@
+package fastly
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestClient_ExportVersion(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/service/foo/version/1/settings":
+			w.Write([]byte(`{"general.default_ttl":3600}`))
+		case "/service/foo/version/1/backend":
+			w.Write([]byte(`[{"name":"origin","address":"example.com"}]`))
+		case "/service/foo/version/1/header":
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"msg":"oops"}`))
+		default:
+			w.Write([]byte(`[]`))
+		}
+	})
+
+	e, err := c.ExportVersion(&ExportVersionInput{ServiceID: "foo", ServiceVersion: 1})
+	merr, ok := err.(*MultiError)
+	if !ok {
+		t.Fatalf("expected *MultiError, got %v", err)
+	}
+	if len(merr.Errors) != 1 || merr.Errors["headers"] == nil {
+		t.Errorf("bad errors: %v", merr.Errors)
+	}
+	if e.Settings == nil || e.Settings.DefaultTTL != 3600 {
+		t.Errorf("bad settings: %+v", e.Settings)
+	}
+	if len(e.Backends) != 1 || e.Backends[0].Name != "origin" {
+		t.Errorf("bad backends: %v", e.Backends)
+	}
+}
+
+func TestClient_ExportVersion_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ExportVersion(&ExportVersionInput{
+		ServiceVersion: 1,
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ExportVersion(&ExportVersionInput{
+		ServiceID: "foo",
+	})
+	if err != ErrMissingServiceVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_ImportVersion(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu    sync.Mutex
+		calls []string
+	)
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		mu.Lock()
+		calls = append(calls, r.Method+" "+r.URL.Path+" "+r.PostForm.Get("name"))
+		mu.Unlock()
+
+		switch {
+		case r.URL.Path == "/service/bar/version":
+			w.Write([]byte(`{"number":7}`))
+		case strings.HasSuffix(r.URL.Path, "/header"):
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"msg":"Bad request","detail":"invalid action"}`))
+		default:
+			w.Write([]byte(`{}`))
+		}
+	})
+
+	e := &VersionExport{
+		ServiceID:      "foo",
+		ServiceVersion: 3,
+		Headers:        []*Header{{ServiceID: "foo", ServiceVersion: 3, Name: "h", Action: HeaderActionSet, Type: HeaderTypeRequest, Destination: "http.X", RequestCondition: "cond"}},
+		Backends:       []*Backend{{ServiceID: "foo", ServiceVersion: 3, Name: "origin", HealthCheck: "check"}},
+		HealthChecks:   []*HealthCheck{{Name: "check"}},
+		Conditions:     []*Condition{{Name: "cond", Type: "REQUEST"}},
+	}
+	v, err := c.ImportVersion(&ImportVersionInput{ServiceID: "bar", Export: e})
+	if v != 7 {
+		t.Errorf("bad version: %d", v)
+	}
+	ierr, ok := err.(*ImportError)
+	if !ok {
+		t.Fatalf("expected *ImportError, got %v", err)
+	}
+	if ierr.Resource != "header" || ierr.Name != "h" || ierr.ServiceVersion != 7 {
+		t.Errorf("bad error: %v", ierr)
+	}
+
+	expected := []string{
+		"POST /service/bar/version ",
+		"POST /service/bar/version/7/condition cond",
+		"POST /service/bar/version/7/healthcheck check",
+		"POST /service/bar/version/7/backend origin",
+		"POST /service/bar/version/7/header h",
+	}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("bad calls: %q", calls)
+	}
+}
+
+func TestClient_ImportVersion_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ImportVersion(&ImportVersionInput{
+		Export: &VersionExport{},
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ImportVersion(&ImportVersionInput{
+		ServiceID: "foo",
+	})
+	if err != ErrMissingExport {
+		t.Errorf("bad error: %s", err)
+	}
+}
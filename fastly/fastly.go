@@ -3,6 +3,7 @@ package fastly
 import (
 	"bytes"
 	"encoding"
+	"encoding/pem"
 	"net/url"
 )
 
@@ -25,6 +26,116 @@ const (
 	MaximumACLSize = 10000
 )
 
+// validateServiceVersionName checks the service ID, service version and name
+// shared by the inputs of most versioned endpoints, returning the matching
+// ErrMissing error for the first one that is unset.
+func validateServiceVersionName(serviceID string, serviceVersion int, name string) error {
+	if serviceID == "" {
+		return ErrMissingServiceID
+	}
+
+	if serviceVersion == 0 {
+		return ErrMissingServiceVersion
+	}
+
+	if name == "" {
+		return ErrMissingName
+	}
+
+	return nil
+}
+
+// validMessageType reports whether t is a message type accepted by the Fastly
+// logging endpoints. An empty value leaves the API default in place.
+func validMessageType(t string) bool {
+	switch t {
+	case "", "classic", "loggly", "logplex", "blank":
+		return true
+	default:
+		return false
+	}
+}
+
+// validFormatVersion reports whether v is a log format version accepted by the
+// Fastly logging endpoints. Zero leaves the API default in place.
+func validFormatVersion(v int) bool {
+	return v >= 0 && v <= 2
+}
+
+const (
+	// TLSVersion10 is TLS 1.0.
+	TLSVersion10 TLSVersion = "1.0"
+
+	// TLSVersion11 is TLS 1.1.
+	TLSVersion11 TLSVersion = "1.1"
+
+	// TLSVersion12 is TLS 1.2.
+	TLSVersion12 TLSVersion = "1.2"
+
+	// TLSVersion13 is TLS 1.3.
+	TLSVersion13 TLSVersion = "1.3"
+)
+
+// TLSVersion is a TLS version accepted for a backend or pool.
+type TLSVersion string
+
+// IsValid reports whether the version is one supported by the Fastly API. An
+// empty version, which leaves the API default in place, is valid.
+func (v TLSVersion) IsValid() bool {
+	switch v {
+	case "", TLSVersion10, TLSVersion11, TLSVersion12, TLSVersion13:
+		return true
+	default:
+		return false
+	}
+}
+
+// PTLSVersion returns pointer to TLSVersion.
+func PTLSVersion(v TLSVersion) *TLSVersion {
+	tv := TLSVersion(v)
+	return &tv
+}
+
+// validateTLSVersions checks the minimum and maximum TLS versions of a backend
+// or pool, including that the minimum does not exceed the maximum.
+func validateTLSVersions(min, max TLSVersion) error {
+	if !min.IsValid() {
+		return ErrInvalidMinTLSVersion
+	}
+	if !max.IsValid() {
+		return ErrInvalidMaxTLSVersion
+	}
+	// The valid versions sort correctly as strings.
+	if min != "" && max != "" && min > max {
+		return ErrInvalidTLSVersionRange
+	}
+	return nil
+}
+
+// validateTLSMaterial checks that any TLS certificates and keys supplied to a
+// logging endpoint are PEM encoded. Empty values are skipped.
+func validateTLSMaterial(caCert, clientCert, clientKey string) error {
+	if caCert != "" && !isPEM(caCert) {
+		return ErrInvalidTLSCACert
+	}
+
+	if clientCert != "" && !isPEM(clientCert) {
+		return ErrInvalidTLSClientCert
+	}
+
+	if clientKey != "" && !isPEM(clientKey) {
+		return ErrInvalidTLSClientKey
+	}
+
+	return nil
+}
+
+// isPEM reports whether s contains at least one PEM block.
+func isPEM(s string) bool {
+	b, _ := pem.Decode([]byte(s))
+	return b != nil
+}
+
 type statusResp struct {
 	Status string
 	Msg    string
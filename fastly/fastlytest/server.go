@@ -0,0 +1,117 @@
+// Package fastlytest provides a mock Fastly API server for testing code that
+// uses the fastly package without talking to the real API.
+package fastlytest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/fastly/go-fastly/v5/fastly"
+)
+
+// APIKey is the API key used by clients returned from Server.Client.
+const APIKey = "fastlytest"
+
+// Call records a request received by a Server.
+type Call struct {
+	Method string
+	Path   string
+}
+
+// Server is a mock Fastly API server. Responses are registered per method and
+// path with Handle or HandleJSON; requests for anything else receive a 404 in
+// the same shape the API uses.
+type Server struct {
+	// URL is the base URL of the server.
+	URL string
+
+	server *httptest.Server
+
+	mu       sync.Mutex
+	handlers map[Call]http.HandlerFunc
+	calls    []Call
+}
+
+// NewServer starts a new Server. Callers should Close it when done.
+func NewServer() *Server {
+	s := &Server{handlers: make(map[Call]http.HandlerFunc)}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Client returns a new fastly.Client pointed at the server.
+func (s *Server) Client() *fastly.Client {
+	c, err := fastly.NewClientForEndpoint(APIKey, s.URL)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Handle registers h to serve requests with the given method and path, such
+// as "GET" and "/service/SERVICE_ID". A later registration for the same method
+// and path replaces the earlier one.
+func (s *Server) Handle(method, path string, h http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[Call{Method: method, Path: path}] = h
+}
+
+// HandleJSON registers a canned response that writes status and the JSON
+// encoding of body for requests with the given method and path.
+func (s *Server) HandleJSON(method, path string, status int, body interface{}) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		panic(fmt.Sprintf("fastlytest: cannot encode response for %s %s: %v", method, path, err))
+	}
+
+	s.Handle(method, path, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write(b)
+	})
+}
+
+// Calls returns the requests received so far, in order.
+func (s *Server) Calls() []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Call(nil), s.calls...)
+}
+
+// Called reports whether a request with the given method and path has been
+// received.
+func (s *Server) Called(method, path string) bool {
+	for _, c := range s.Calls() {
+		if c.Method == method && c.Path == path {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	call := Call{Method: r.Method, Path: r.URL.Path}
+
+	s.mu.Lock()
+	s.calls = append(s.calls, call)
+	h, ok := s.handlers[call]
+	s.mu.Unlock()
+
+	if !ok {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"msg":"Record not found","detail":"no handler registered for %s %s"}`, call.Method, call.Path)
+		return
+	}
+	h(w, r)
+}
@@ -0,0 +1,56 @@
+package fastlytest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/fastly/go-fastly/v5/fastly"
+)
+
+func TestServer(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	s.HandleJSON("GET", "/service/SERVICE_ID", http.StatusOK, map[string]interface{}{
+		"id":   "SERVICE_ID",
+		"name": "my-service",
+	})
+
+	c := s.Client()
+	svc, err := c.GetService(&fastly.GetServiceInput{ID: "SERVICE_ID"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if svc.Name != "my-service" {
+		t.Errorf("bad name: %q", svc.Name)
+	}
+	if !s.Called("GET", "/service/SERVICE_ID") {
+		t.Error("expected GET /service/SERVICE_ID to be called")
+	}
+
+	_, err = c.GetService(&fastly.GetServiceInput{ID: "MISSING"})
+	herr, ok := err.(*fastly.HTTPError)
+	if !ok || !herr.IsNotFound() {
+		t.Errorf("expected not found error, got %v", err)
+	}
+
+	if calls := s.Calls(); len(calls) != 2 {
+		t.Errorf("bad calls: %v", calls)
+	}
+}
+
+func TestServer_Handle(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	s.Handle("DELETE", "/service/SERVICE_ID", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(fastly.APIKeyHeader) != APIKey {
+			t.Errorf("bad key: %q", r.Header.Get(fastly.APIKeyHeader))
+		}
+		w.Write([]byte(`{"status":"ok"}`))
+	})
+
+	if err := s.Client().DeleteService(&fastly.DeleteServiceInput{ID: "SERVICE_ID"}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -9,28 +9,28 @@ import (
 
 // FTP represents an FTP logging response from the Fastly API.
 type FTP struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name              string     `mapstructure:"name"`
-	Address           string     `mapstructure:"address"`
-	Port              uint       `mapstructure:"port"`
-	Username          string     `mapstructure:"user"`
-	Password          string     `mapstructure:"password"`
-	PublicKey         string     `mapstructure:"public_key"`
-	Path              string     `mapstructure:"path"`
-	Period            uint       `mapstructure:"period"`
-	CompressionCodec  string     `mapstructure:"compression_codec"`
-	GzipLevel         uint8      `mapstructure:"gzip_level"`
-	Format            string     `mapstructure:"format"`
-	FormatVersion     uint       `mapstructure:"format_version"`
-	ResponseCondition string     `mapstructure:"response_condition"`
-	TimestampFormat   string     `mapstructure:"timestamp_format"`
-	MessageType       string     `mapstructure:"message_type"`
-	Placement         string     `mapstructure:"placement"`
-	CreatedAt         *time.Time `mapstructure:"created_at"`
-	UpdatedAt         *time.Time `mapstructure:"updated_at"`
-	DeletedAt         *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name              string     `mapstructure:"name" json:"name"`
+	Address           string     `mapstructure:"address" json:"address"`
+	Port              uint       `mapstructure:"port" json:"port"`
+	Username          string     `mapstructure:"user" json:"user"`
+	Password          string     `mapstructure:"password" json:"password"`
+	PublicKey         string     `mapstructure:"public_key" json:"public_key"`
+	Path              string     `mapstructure:"path" json:"path"`
+	Period            uint       `mapstructure:"period" json:"period"`
+	CompressionCodec  string     `mapstructure:"compression_codec" json:"compression_codec"`
+	GzipLevel         uint8      `mapstructure:"gzip_level" json:"gzip_level"`
+	Format            string     `mapstructure:"format" json:"format"`
+	FormatVersion     uint       `mapstructure:"format_version" json:"format_version"`
+	ResponseCondition string     `mapstructure:"response_condition" json:"response_condition"`
+	TimestampFormat   string     `mapstructure:"timestamp_format" json:"timestamp_format"`
+	MessageType       string     `mapstructure:"message_type" json:"message_type"`
+	Placement         string     `mapstructure:"placement" json:"placement"`
+	CreatedAt         *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // ftpsByName is a sortable list of ftps.
@@ -102,14 +102,16 @@ type CreateFTPInput struct {
 	Placement         string `url:"placement,omitempty"`
 }
 
-// CreateFTP creates a new Fastly FTP.
+// CreateFTP creates a new Fastly FTP. Port defaults to 21 when unset.
 func (c *Client) CreateFTP(i *CreateFTPInput) (*FTP, error) {
-	if i.ServiceID == "" {
-		return nil, ErrMissingServiceID
+	if err := validateServiceVersionName(i.ServiceID, i.ServiceVersion, i.Name); err != nil {
+		return nil, err
 	}
 
-	if i.ServiceVersion == 0 {
-		return nil, ErrMissingServiceVersion
+	if i.Port == 0 {
+		in := *i
+		in.Port = 21
+		i = &in
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/logging/ftp", i.ServiceID, i.ServiceVersion)
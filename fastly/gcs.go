@@ -9,26 +9,27 @@ import (
 
 // GCS represents an GCS logging response from the Fastly API.
 type GCS struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name              string     `mapstructure:"name"`
-	Bucket            string     `mapstructure:"bucket_name"`
-	User              string     `mapstructure:"user"`
-	SecretKey         string     `mapstructure:"secret_key"`
-	Path              string     `mapstructure:"path"`
-	Period            uint       `mapstructure:"period"`
-	CompressionCodec  string     `mapstructure:"compression_codec"`
-	GzipLevel         uint8      `mapstructure:"gzip_level"`
-	Format            string     `mapstructure:"format"`
-	FormatVersion     uint       `mapstructure:"format_version"`
-	MessageType       string     `mapstructure:"message_type"`
-	ResponseCondition string     `mapstructure:"response_condition"`
-	TimestampFormat   string     `mapstructure:"timestamp_format"`
-	Placement         string     `mapstructure:"placement"`
-	CreatedAt         *time.Time `mapstructure:"created_at"`
-	UpdatedAt         *time.Time `mapstructure:"updated_at"`
-	DeletedAt         *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name              string     `mapstructure:"name" json:"name"`
+	Bucket            string     `mapstructure:"bucket_name" json:"bucket_name"`
+	User              string     `mapstructure:"user" json:"user"`
+	SecretKey         string     `mapstructure:"secret_key" json:"secret_key"`
+	AccountName       string     `mapstructure:"account_name" json:"account_name"`
+	Path              string     `mapstructure:"path" json:"path"`
+	Period            uint       `mapstructure:"period" json:"period"`
+	CompressionCodec  string     `mapstructure:"compression_codec" json:"compression_codec"`
+	GzipLevel         uint8      `mapstructure:"gzip_level" json:"gzip_level"`
+	Format            string     `mapstructure:"format" json:"format"`
+	FormatVersion     uint       `mapstructure:"format_version" json:"format_version"`
+	MessageType       string     `mapstructure:"message_type" json:"message_type"`
+	ResponseCondition string     `mapstructure:"response_condition" json:"response_condition"`
+	TimestampFormat   string     `mapstructure:"timestamp_format" json:"timestamp_format"`
+	Placement         string     `mapstructure:"placement" json:"placement"`
+	CreatedAt         *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // gcsesByName is a sortable list of gcses.
@@ -86,6 +87,7 @@ type CreateGCSInput struct {
 	Bucket            string `url:"bucket_name,omitempty"`
 	User              string `url:"user,omitempty"`
 	SecretKey         string `url:"secret_key,omitempty"`
+	AccountName       string `url:"account_name,omitempty"`
 	Path              string `url:"path,omitempty"`
 	Period            uint   `url:"period,omitempty"`
 	FormatVersion     uint   `url:"format_version,omitempty"`
@@ -108,6 +110,18 @@ func (c *Client) CreateGCS(i *CreateGCSInput) (*GCS, error) {
 		return nil, ErrMissingServiceVersion
 	}
 
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	if !validMessageType(i.MessageType) {
+		return nil, ErrInvalidMessageType
+	}
+
+	if i.GzipLevel != 0 && i.CompressionCodec != "" {
+		return nil, ErrGzipLevelWithCompressionCodec
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/logging/gcs", i.ServiceID, i.ServiceVersion)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
@@ -175,6 +189,7 @@ type UpdateGCSInput struct {
 	Bucket            *string `url:"bucket_name,omitempty"`
 	User              *string `url:"user,omitempty"`
 	SecretKey         *string `url:"secret_key,omitempty"`
+	AccountName       *string `url:"account_name,omitempty"`
 	Path              *string `url:"path,omitempty"`
 	Period            *uint   `url:"period,omitempty"`
 	FormatVersion     *uint   `url:"format_version,omitempty"`
@@ -201,6 +216,14 @@ func (c *Client) UpdateGCS(i *UpdateGCSInput) (*GCS, error) {
 		return nil, ErrMissingName
 	}
 
+	if i.MessageType != nil && !validMessageType(*i.MessageType) {
+		return nil, ErrInvalidMessageType
+	}
+
+	if i.GzipLevel != nil && *i.GzipLevel != 0 && i.CompressionCodec != nil && *i.CompressionCodec != "" {
+		return nil, ErrGzipLevelWithCompressionCodec
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/logging/gcs/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
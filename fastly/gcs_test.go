@@ -370,6 +370,26 @@ func TestClient_CreateGCS_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateGCS(&CreateGCSInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateGCS(&CreateGCSInput{
+		ServiceID:        "foo",
+		ServiceVersion:   1,
+		Name:             "test",
+		CompressionCodec: "snappy",
+		GzipLevel:        8,
+	})
+	if err != ErrGzipLevelWithCompressionCodec {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetGCS_validation(t *testing.T) {
@@ -424,6 +444,17 @@ func TestClient_UpdateGCS_validation(t *testing.T) {
 	if err != ErrMissingName {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.UpdateGCS(&UpdateGCSInput{
+		ServiceID:        "foo",
+		ServiceVersion:   1,
+		Name:             "test",
+		CompressionCodec: String("zstd"),
+		GzipLevel:        Uint8(9),
+	})
+	if err != ErrGzipLevelWithCompressionCodec {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_DeleteGCS_validation(t *testing.T) {
@@ -7,18 +7,18 @@ import (
 	"time"
 )
 
-// Gzip represents an Gzip logging response from the Fastly API.
+// Gzip represents a Gzip response from the Fastly API.
 type Gzip struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name           string     `mapstructure:"name"`
-	ContentTypes   string     `mapstructure:"content_types"`
-	Extensions     string     `mapstructure:"extensions"`
-	CacheCondition string     `mapstructure:"cache_condition"`
-	CreatedAt      *time.Time `mapstructure:"created_at"`
-	UpdatedAt      *time.Time `mapstructure:"updated_at"`
-	DeletedAt      *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name           string     `mapstructure:"name" json:"name"`
+	ContentTypes   []string   `mapstructure:"content_types" json:"content_types"`
+	Extensions     []string   `mapstructure:"extensions" json:"extensions"`
+	CacheCondition string     `mapstructure:"cache_condition" json:"cache_condition"`
+	CreatedAt      *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt      *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt      *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // gzipsByName is a sortable list of gzips.
@@ -57,7 +57,7 @@ func (c *Client) ListGzips(i *ListGzipsInput) ([]*Gzip, error) {
 	}
 
 	var gzips []*Gzip
-	if err := decodeBodyMap(resp.Body, &gzips); err != nil {
+	if err := decodeBodyMap(resp.Body, &gzips, stringToSliceHookFunc()); err != nil {
 		return nil, err
 	}
 	sort.Stable(gzipsByName(gzips))
@@ -72,10 +72,13 @@ type CreateGzipInput struct {
 	// ServiceVersion is the specific configuration version (required).
 	ServiceVersion int
 
-	Name           string `url:"name,omitempty"`
-	ContentTypes   string `url:"content_types,omitempty"`
-	Extensions     string `url:"extensions,omitempty"`
-	CacheCondition string `url:"cache_condition,omitempty"`
+	Name string `url:"name,omitempty"`
+
+	// ContentTypes and Extensions are sent to the API as space-separated
+	// lists.
+	ContentTypes   []string `url:"content_types,space,omitempty"`
+	Extensions     []string `url:"extensions,space,omitempty"`
+	CacheCondition string   `url:"cache_condition,omitempty"`
 }
 
 // CreateGzip creates a new Fastly Gzip.
@@ -95,7 +98,7 @@ func (c *Client) CreateGzip(i *CreateGzipInput) (*Gzip, error) {
 	}
 
 	var gzip *Gzip
-	if err := decodeBodyMap(resp.Body, &gzip); err != nil {
+	if err := decodeBodyMap(resp.Body, &gzip, stringToSliceHookFunc()); err != nil {
 		return nil, err
 	}
 	return gzip, nil
@@ -134,7 +137,7 @@ func (c *Client) GetGzip(i *GetGzipInput) (*Gzip, error) {
 	}
 
 	var b *Gzip
-	if err := decodeBodyMap(resp.Body, &b); err != nil {
+	if err := decodeBodyMap(resp.Body, &b, stringToSliceHookFunc()); err != nil {
 		return nil, err
 	}
 	return b, nil
@@ -151,10 +154,13 @@ type UpdateGzipInput struct {
 	// Name is the name of the Gzip to update.
 	Name string
 
-	NewName        *string `url:"name,omitempty"`
-	ContentTypes   *string `url:"content_types,omitempty"`
-	Extensions     *string `url:"extensions,omitempty"`
-	CacheCondition *string `url:"cache_condition,omitempty"`
+	NewName *string `url:"name,omitempty"`
+
+	// ContentTypes and Extensions are sent to the API as space-separated
+	// lists. Setting either to an empty slice clears it.
+	ContentTypes   *[]string `url:"content_types,space,omitempty"`
+	Extensions     *[]string `url:"extensions,space,omitempty"`
+	CacheCondition *string   `url:"cache_condition,omitempty"`
 }
 
 // UpdateGzip updates a specific Gzip.
@@ -178,7 +184,7 @@ func (c *Client) UpdateGzip(i *UpdateGzipInput) (*Gzip, error) {
 	}
 
 	var b *Gzip
-	if err := decodeBodyMap(resp.Body, &b); err != nil {
+	if err := decodeBodyMap(resp.Body, &b, stringToSliceHookFunc()); err != nil {
 		return nil, err
 	}
 	return b, nil
@@ -1,6 +1,8 @@
 package fastly
 
 import (
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -20,8 +22,8 @@ func TestClient_Gzips(t *testing.T) {
 			ServiceID:      testServiceID,
 			ServiceVersion: tv.Number,
 			Name:           "test-gzip",
-			ContentTypes:   "text/html text/css",
-			Extensions:     "html css",
+			ContentTypes:   []string{"text/html", "text/css"},
+			Extensions:     []string{"html", "css"},
 		})
 	})
 	if err != nil {
@@ -41,10 +43,10 @@ func TestClient_Gzips(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if gzipomit.ContentTypes != "text/html application/x-javascript text/css application/javascript text/javascript application/json application/vnd.ms-fontobject application/x-font-opentype application/x-font-truetype application/x-font-ttf application/xml font/eot font/opentype font/otf image/svg+xml image/vnd.microsoft.icon text/plain text/xml" {
+	if strings.Join(gzipomit.ContentTypes, " ") != "text/html application/x-javascript text/css application/javascript text/javascript application/json application/vnd.ms-fontobject application/x-font-opentype application/x-font-truetype application/x-font-ttf application/xml font/eot font/opentype font/otf image/svg+xml image/vnd.microsoft.icon text/plain text/xml" {
 		t.Errorf("bad content_types: %q", gzipomit.ContentTypes)
 	}
-	if gzipomit.Extensions != "css js html eot ico otf ttf json" {
+	if strings.Join(gzipomit.Extensions, " ") != "css js html eot ico otf ttf json" {
 		t.Errorf("bad extensions: %q", gzipomit.Extensions)
 	}
 
@@ -74,10 +76,10 @@ func TestClient_Gzips(t *testing.T) {
 	if gzip.Name != "test-gzip" {
 		t.Errorf("bad name: %q", gzip.Name)
 	}
-	if gzip.ContentTypes != "text/html text/css" {
+	if strings.Join(gzip.ContentTypes, " ") != "text/html text/css" {
 		t.Errorf("bad content_types: %q", gzip.ContentTypes)
 	}
-	if gzip.Extensions != "html css" {
+	if strings.Join(gzip.Extensions, " ") != "html css" {
 		t.Errorf("bad extensions: %q", gzip.Extensions)
 	}
 
@@ -111,10 +113,10 @@ func TestClient_Gzips(t *testing.T) {
 	if ngzip.Name != gzip.Name {
 		t.Errorf("bad name: %q", ngzip.Name)
 	}
-	if ngzip.ContentTypes != gzip.ContentTypes {
+	if !reflect.DeepEqual(ngzip.ContentTypes, gzip.ContentTypes) {
 		t.Errorf("bad content_types: %q", ngzip.ContentTypes)
 	}
-	if ngzip.Extensions != gzip.Extensions {
+	if !reflect.DeepEqual(ngzip.Extensions, gzip.Extensions) {
 		t.Errorf("bad extensions: %q", ngzip.Extensions)
 	}
 
@@ -264,3 +266,27 @@ func TestClient_DeleteGzip_validation(t *testing.T) {
 		t.Errorf("bad error: %s", err)
 	}
 }
+
+func TestDecodeMap_stringToSliceOnlyForGzip(t *testing.T) {
+	in := map[string]interface{}{"content_types": "text/html text/css"}
+
+	var g Gzip
+	if err := decodeMap(in, &g, stringToSliceHookFunc()); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(g.ContentTypes, []string{"text/html", "text/css"}) {
+		t.Errorf("bad content_types: %q", g.ContentTypes)
+	}
+
+	// Other []string fields keep the default decoding, which does not split
+	// the string.
+	var other struct {
+		ContentTypes []string `mapstructure:"content_types"`
+	}
+	if err := decodeMap(in, &other); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(other.ContentTypes, []string{"text/html text/css"}) {
+		t.Errorf("bad content_types: %q", other.ContentTypes)
+	}
+}
@@ -29,6 +29,16 @@ const (
 // HeaderAction is a type of header action.
 type HeaderAction string
 
+// IsValid reports whether the action is one supported by the Fastly API.
+func (a HeaderAction) IsValid() bool {
+	switch a {
+	case HeaderActionSet, HeaderActionAppend, HeaderActionDelete, HeaderActionRegex, HeaderActionRegexRepeat:
+		return true
+	default:
+		return false
+	}
+}
+
 // PHeaderAction returns pointer to HeaderAction.
 func PHeaderAction(t HeaderAction) *HeaderAction {
 	ha := HeaderAction(t)
@@ -56,6 +66,16 @@ const (
 // HeaderType is a type of header.
 type HeaderType string
 
+// IsValid reports whether the type is one supported by the Fastly API.
+func (t HeaderType) IsValid() bool {
+	switch t {
+	case HeaderTypeRequest, HeaderTypeFetch, HeaderTypeCache, HeaderTypeResponse:
+		return true
+	default:
+		return false
+	}
+}
+
 // PHeaderType returns pointer to HeaderType.
 func PHeaderType(t HeaderType) *HeaderType {
 	ht := HeaderType(t)
@@ -64,24 +84,24 @@ func PHeaderType(t HeaderType) *HeaderType {
 
 // Header represents a header response from the Fastly API.
 type Header struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name              string       `mapstructure:"name"`
-	Action            HeaderAction `mapstructure:"action"`
-	IgnoreIfSet       bool         `mapstructure:"ignore_if_set"`
-	Type              HeaderType   `mapstructure:"type"`
-	Destination       string       `mapstructure:"dst"`
-	Source            string       `mapstructure:"src"`
-	Regex             string       `mapstructure:"regex"`
-	Substitution      string       `mapstructure:"substitution"`
-	Priority          uint         `mapstructure:"priority"`
-	RequestCondition  string       `mapstructure:"request_condition"`
-	CacheCondition    string       `mapstructure:"cache_condition"`
-	ResponseCondition string       `mapstructure:"response_condition"`
-	CreatedAt         *time.Time   `mapstructure:"created_at"`
-	UpdatedAt         *time.Time   `mapstructure:"updated_at"`
-	DeletedAt         *time.Time   `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name              string       `mapstructure:"name" json:"name"`
+	Action            HeaderAction `mapstructure:"action" json:"action"`
+	IgnoreIfSet       bool         `mapstructure:"ignore_if_set" json:"ignore_if_set"`
+	Type              HeaderType   `mapstructure:"type" json:"type"`
+	Destination       string       `mapstructure:"dst" json:"dst"`
+	Source            string       `mapstructure:"src" json:"src"`
+	Regex             string       `mapstructure:"regex" json:"regex"`
+	Substitution      string       `mapstructure:"substitution" json:"substitution"`
+	Priority          uint         `mapstructure:"priority" json:"priority"`
+	RequestCondition  string       `mapstructure:"request_condition" json:"request_condition"`
+	CacheCondition    string       `mapstructure:"cache_condition" json:"cache_condition"`
+	ResponseCondition string       `mapstructure:"response_condition" json:"response_condition"`
+	CreatedAt         *time.Time   `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt         *time.Time   `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt         *time.Time   `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // headersByName is a sortable list of headers.
@@ -159,6 +179,26 @@ func (c *Client) CreateHeader(i *CreateHeaderInput) (*Header, error) {
 		return nil, ErrMissingServiceVersion
 	}
 
+	if i.Action != "" && !i.Action.IsValid() {
+		return nil, ErrInvalidHeaderAction
+	}
+
+	if i.Type != "" && !i.Type.IsValid() {
+		return nil, ErrInvalidHeaderType
+	}
+
+	if i.Destination == "" {
+		return nil, ErrMissingDestination
+	}
+
+	if err := c.checkConditions(i.ServiceID, i.ServiceVersion,
+		conditionRef{"RequestCondition", i.RequestCondition, ConditionTypeRequest},
+		conditionRef{"CacheCondition", i.CacheCondition, ConditionTypeCache},
+		conditionRef{"ResponseCondition", i.ResponseCondition, ConditionTypeResponse},
+	); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/header", i.ServiceID, i.ServiceVersion)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
@@ -250,6 +290,26 @@ func (c *Client) UpdateHeader(i *UpdateHeaderInput) (*Header, error) {
 		return nil, ErrMissingName
 	}
 
+	if i.Action != nil && !i.Action.IsValid() {
+		return nil, ErrInvalidHeaderAction
+	}
+
+	if i.Type != nil && !i.Type.IsValid() {
+		return nil, ErrInvalidHeaderType
+	}
+
+	if i.Destination != nil && *i.Destination == "" {
+		return nil, ErrMissingDestination
+	}
+
+	if err := c.checkConditions(i.ServiceID, i.ServiceVersion,
+		optionalConditionRef("RequestCondition", i.RequestCondition, ConditionTypeRequest),
+		optionalConditionRef("CacheCondition", i.CacheCondition, ConditionTypeCache),
+		optionalConditionRef("ResponseCondition", i.ResponseCondition, ConditionTypeResponse),
+	); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/header/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
@@ -200,6 +200,33 @@ func TestClient_CreateHeader_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateHeader(&CreateHeaderInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Action:         HeaderAction("bogus"),
+	})
+	if err != ErrInvalidHeaderAction {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateHeader(&CreateHeaderInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Type:           HeaderType("bogus"),
+	})
+	if err != ErrInvalidHeaderType {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateHeader(&CreateHeaderInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Destination:    "",
+	})
+	if err != ErrMissingDestination {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetHeader_validation(t *testing.T) {
@@ -254,6 +281,26 @@ func TestClient_UpdateHeader_validation(t *testing.T) {
 	if err != ErrMissingName {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.UpdateHeader(&UpdateHeaderInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Action:         PHeaderAction("bogus"),
+	})
+	if err != ErrInvalidHeaderAction {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateHeader(&UpdateHeaderInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Destination:    String(""),
+	})
+	if err != ErrMissingDestination {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_DeleteHeader_validation(t *testing.T) {
@@ -2,6 +2,7 @@ package fastly
 
 import (
 	"fmt"
+	"net/http"
 	"net/url"
 	"sort"
 	"time"
@@ -9,24 +10,36 @@ import (
 
 // HealthCheck represents a health check response from the Fastly API.
 type HealthCheck struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name             string     `mapstructure:"name"`
-	Comment          string     `mapstructure:"comment"`
-	Method           string     `mapstructure:"method"`
-	Host             string     `mapstructure:"host"`
-	Path             string     `mapstructure:"path"`
-	HTTPVersion      string     `mapstructure:"http_version"`
-	Timeout          uint       `mapstructure:"timeout"`
-	CheckInterval    uint       `mapstructure:"check_interval"`
-	ExpectedResponse uint       `mapstructure:"expected_response"`
-	Window           uint       `mapstructure:"window"`
-	Threshold        uint       `mapstructure:"threshold"`
-	Initial          uint       `mapstructure:"initial"`
-	CreatedAt        *time.Time `mapstructure:"created_at"`
-	UpdatedAt        *time.Time `mapstructure:"updated_at"`
-	DeletedAt        *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name             string     `mapstructure:"name" json:"name"`
+	Comment          string     `mapstructure:"comment" json:"comment"`
+	Method           string     `mapstructure:"method" json:"method"`
+	Host             string     `mapstructure:"host" json:"host"`
+	Path             string     `mapstructure:"path" json:"path"`
+	HTTPVersion      string     `mapstructure:"http_version" json:"http_version"`
+	Timeout          uint       `mapstructure:"timeout" json:"timeout"`
+	CheckInterval    uint       `mapstructure:"check_interval" json:"check_interval"`
+	ExpectedResponse uint       `mapstructure:"expected_response" json:"expected_response"`
+	Window           uint       `mapstructure:"window" json:"window"`
+	Threshold        uint       `mapstructure:"threshold" json:"threshold"`
+	Initial          uint       `mapstructure:"initial" json:"initial"`
+	CreatedAt        *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt        *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt        *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
+}
+
+// validHealthCheckMethod reports whether m is an HTTP method that may be used
+// for a health check request.
+func validHealthCheckMethod(m string) bool {
+	switch m {
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut,
+		http.MethodPatch, http.MethodDelete, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
 }
 
 // healthChecksByName is a sortable list of health checks.
@@ -105,6 +118,18 @@ func (c *Client) CreateHealthCheck(i *CreateHealthCheckInput) (*HealthCheck, err
 		return nil, ErrMissingServiceVersion
 	}
 
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	if i.Method != "" && !validHealthCheckMethod(i.Method) {
+		return nil, ErrInvalidMethod
+	}
+
+	if i.ExpectedResponse != nil && !validHTTPStatus(*i.ExpectedResponse) {
+		return nil, ErrInvalidExpectedResponse
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/healthcheck", i.ServiceID, i.ServiceVersion)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
@@ -196,6 +221,14 @@ func (c *Client) UpdateHealthCheck(i *UpdateHealthCheckInput) (*HealthCheck, err
 		return nil, ErrMissingName
 	}
 
+	if i.Method != nil && !validHealthCheckMethod(*i.Method) {
+		return nil, ErrInvalidMethod
+	}
+
+	if i.ExpectedResponse != nil && !validHTTPStatus(*i.ExpectedResponse) {
+		return nil, ErrInvalidExpectedResponse
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/healthcheck/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
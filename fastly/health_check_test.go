@@ -212,6 +212,35 @@ func TestClient_CreateHealthCheck_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateHealthCheck(&CreateHealthCheckInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateHealthCheck(&CreateHealthCheckInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Method:         "FETCH",
+	})
+	if err != ErrInvalidMethod {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateHealthCheck(&CreateHealthCheckInput{
+		ServiceID:        "foo",
+		ServiceVersion:   1,
+		Name:             "test",
+		ExpectedResponse: Uint(42),
+	})
+	if err != ErrInvalidExpectedResponse {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetHealthCheck_validation(t *testing.T) {
@@ -266,6 +295,26 @@ func TestClient_UpdateHealthCheck_validation(t *testing.T) {
 	if err != ErrMissingName {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.UpdateHealthCheck(&UpdateHealthCheckInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Method:         String("get"),
+	})
+	if err != ErrInvalidMethod {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateHealthCheck(&UpdateHealthCheckInput{
+		ServiceID:        "foo",
+		ServiceVersion:   1,
+		Name:             "test",
+		ExpectedResponse: Uint(600),
+	})
+	if err != ErrInvalidExpectedResponse {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_DeleteHealthCheck_validation(t *testing.T) {
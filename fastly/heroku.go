@@ -9,19 +9,19 @@ import (
 
 // Heroku represents a heroku response from the Fastly API.
 type Heroku struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name              string     `mapstructure:"name"`
-	Format            string     `mapstructure:"format"`
-	FormatVersion     uint       `mapstructure:"format_version"`
-	URL               string     `mapstructure:"url"`
-	Token             string     `mapstructure:"token"`
-	ResponseCondition string     `mapstructure:"response_condition"`
-	Placement         string     `mapstructure:"placement"`
-	CreatedAt         *time.Time `mapstructure:"created_at"`
-	UpdatedAt         *time.Time `mapstructure:"updated_at"`
-	DeletedAt         *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name              string     `mapstructure:"name" json:"name"`
+	Format            string     `mapstructure:"format" json:"format"`
+	FormatVersion     uint       `mapstructure:"format_version" json:"format_version"`
+	URL               string     `mapstructure:"url" json:"url"`
+	Token             string     `mapstructure:"token" json:"token"`
+	ResponseCondition string     `mapstructure:"response_condition" json:"response_condition"`
+	Placement         string     `mapstructure:"placement" json:"placement"`
+	CreatedAt         *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // herokusByName is a sortable list of herokus.
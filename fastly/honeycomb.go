@@ -9,19 +9,19 @@ import (
 
 // Honeycomb represents a honeycomb response from the Fastly API.
 type Honeycomb struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name              string     `mapstructure:"name"`
-	Format            string     `mapstructure:"format"`
-	FormatVersion     uint       `mapstructure:"format_version"`
-	Dataset           string     `mapstructure:"dataset"`
-	Token             string     `mapstructure:"token"`
-	ResponseCondition string     `mapstructure:"response_condition"`
-	Placement         string     `mapstructure:"placement"`
-	CreatedAt         *time.Time `mapstructure:"created_at"`
-	UpdatedAt         *time.Time `mapstructure:"updated_at"`
-	DeletedAt         *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name              string     `mapstructure:"name" json:"name"`
+	Format            string     `mapstructure:"format" json:"format"`
+	FormatVersion     uint       `mapstructure:"format_version" json:"format_version"`
+	Dataset           string     `mapstructure:"dataset" json:"dataset"`
+	Token             string     `mapstructure:"token" json:"token"`
+	ResponseCondition string     `mapstructure:"response_condition" json:"response_condition"`
+	Placement         string     `mapstructure:"placement" json:"placement"`
+	CreatedAt         *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // honeycombsByName is a sortable list of honeycombs.
@@ -86,12 +86,8 @@ type CreateHoneycombInput struct {
 
 // CreateHoneycomb creates a new Fastly honeycomb.
 func (c *Client) CreateHoneycomb(i *CreateHoneycombInput) (*Honeycomb, error) {
-	if i.ServiceID == "" {
-		return nil, ErrMissingServiceID
-	}
-
-	if i.ServiceVersion == 0 {
-		return nil, ErrMissingServiceVersion
+	if err := validateServiceVersionName(i.ServiceID, i.ServiceVersion, i.Name); err != nil {
+		return nil, err
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/logging/honeycomb", i.ServiceID, i.ServiceVersion)
@@ -0,0 +1,179 @@
+package fastly
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// redactedValue replaces secrets in requests handed to a request hook and in
+// API error messages.
+const redactedValue = "[REDACTED]"
+
+// DefaultRedactedFields are the fields whose values are always redacted. They
+// cover the secrets accepted by the Fastly API, such as logging endpoint
+// credentials, TLS client keys and TLS private keys.
+var DefaultRedactedFields = []string{
+	"secret_key",
+	"access_key",
+	"password",
+	"token",
+	"sas_token",
+	"tls_client_key",
+	"ssl_client_key",
+	"key",
+}
+
+var defaultRedactor = newRedactor(DefaultRedactedFields)
+
+// redactor replaces the values of a set of fields in form-encoded or JSON
+// text.
+type redactor struct {
+	fields map[string]bool
+	form   *regexp.Regexp
+	json   *regexp.Regexp
+}
+
+func newRedactor(fields []string) *redactor {
+	quoted := make([]string, len(fields))
+	set := make(map[string]bool, len(fields))
+	for n, f := range fields {
+		quoted[n] = regexp.QuoteMeta(f)
+		set[f] = true
+	}
+	names := strings.Join(quoted, "|")
+	return &redactor{
+		fields: set,
+		form:   regexp.MustCompile(`(^|&|\s)(` + names + `)=[^&\s]*`),
+		json:   regexp.MustCompile(`"(` + names + `)"(\s*):(\s*)"(?:[^"\\]|\\.)*"`),
+	}
+}
+
+// SetRedactedFields sets the fields whose values are redacted, in addition to
+// DefaultRedactedFields, from the requests handed to the request hook and
+// from the messages of returned *HTTPError values. Each call replaces the
+// fields given to the previous one.
+func (c *Client) SetRedactedFields(fields ...string) {
+	all := make([]string, 0, len(DefaultRedactedFields)+len(fields))
+	all = append(all, DefaultRedactedFields...)
+	rd := newRedactor(append(all, fields...))
+
+	c.configLock.Lock()
+	c.redactor = rd
+	c.configLock.Unlock()
+}
+
+// redaction returns the redactor used by c.
+func (c *Client) redaction() *redactor {
+	c.configLock.RLock()
+	defer c.configLock.RUnlock()
+
+	if c.redactor == nil {
+		return defaultRedactor
+	}
+	return c.redactor
+}
+
+// SetRequestHook registers f to be called before each request is sent. f
+// receives a copy of the request in which the Fastly-Key header and the
+// values of the redacted fields (see SetRedactedFields) are redacted, so it
+// is safe to log. Bodies that cannot be re-read, such as package uploads, are
+// omitted. Passing nil removes the hook.
+func (c *Client) SetRequestHook(f func(*http.Request)) {
+	c.configLock.Lock()
+	c.requestHook = f
+	c.configLock.Unlock()
+}
+
+// SetResponseHook registers f to be called with each response and the time
+// taken to obtain it, including any retries. f must not read or close the
+// response body. Passing nil removes the hook.
+func (c *Client) SetResponseHook(f func(*http.Response, time.Duration)) {
+	c.configLock.Lock()
+	c.responseHook = f
+	c.configLock.Unlock()
+}
+
+// doWithHooks sends req using do, invoking the request and response hooks
+// around it.
+func (c *Client) doWithHooks(req *http.Request, do func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	c.configLock.RLock()
+	requestHook, responseHook := c.requestHook, c.responseHook
+	c.configLock.RUnlock()
+
+	if requestHook != nil {
+		requestHook(c.redaction().request(req))
+	}
+
+	start := time.Now()
+	resp, err := do(req)
+	if responseHook != nil && resp != nil {
+		responseHook(resp, time.Since(start))
+	}
+	return resp, err
+}
+
+// request returns a copy of req with its API key and secret body fields
+// redacted. The body of req itself is left untouched.
+func (rd *redactor) request(req *http.Request) *http.Request {
+	r := req.Clone(req.Context())
+	if r.Header.Get(APIKeyHeader) != "" {
+		r.Header.Set(APIKeyHeader, redactedValue)
+	}
+
+	r.Body = http.NoBody
+	r.GetBody = nil
+	if req.GetBody == nil {
+		return r
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return r
+	}
+	defer body.Close()
+
+	b, err := ioutil.ReadAll(body)
+	if err != nil {
+		return r
+	}
+	b = rd.body(b)
+	r.Body = ioutil.NopCloser(bytes.NewReader(b))
+	r.ContentLength = int64(len(b))
+	return r
+}
+
+// body replaces the values of the redacted fields in a form-encoded or JSON
+// body.
+func (rd *redactor) body(b []byte) []byte {
+	b = rd.form.ReplaceAll(b, []byte("${1}${2}="+redactedValue))
+	return rd.json.ReplaceAll(b, []byte(`"${1}"${2}:${3}"`+redactedValue+`"`))
+}
+
+// error redacts the messages of err when it is an *HTTPError, since the API
+// sometimes echoes the request body back in them.
+func (rd *redactor) error(err error) error {
+	herr, ok := err.(*HTTPError)
+	if !ok {
+		return err
+	}
+
+	for _, e := range herr.Errors {
+		e.Title = string(rd.body([]byte(e.Title)))
+		e.Detail = string(rd.body([]byte(e.Detail)))
+		if e.Meta == nil {
+			continue
+		}
+		for k, v := range *e.Meta {
+			if rd.fields[k] {
+				(*e.Meta)[k] = redactedValue
+			} else if s, ok := v.(string); ok {
+				(*e.Meta)[k] = string(rd.body([]byte(s)))
+			}
+		}
+	}
+	return herr
+}
@@ -2,6 +2,7 @@ package fastly
 
 import (
 	"fmt"
+	"net/http"
 	"net/url"
 	"sort"
 	"time"
@@ -9,30 +10,30 @@ import (
 
 // HTTPS represents an HTTPS Logging response from the Fastly API.
 type HTTPS struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name              string     `mapstructure:"name"`
-	ResponseCondition string     `mapstructure:"response_condition"`
-	Format            string     `mapstructure:"format"`
-	URL               string     `mapstructure:"url"`
-	RequestMaxEntries uint       `mapstructure:"request_max_entries"`
-	RequestMaxBytes   uint       `mapstructure:"request_max_bytes"`
-	ContentType       string     `mapstructure:"content_type"`
-	HeaderName        string     `mapstructure:"header_name"`
-	HeaderValue       string     `mapstructure:"header_value"`
-	Method            string     `mapstructure:"method"`
-	JSONFormat        string     `mapstructure:"json_format"`
-	Placement         string     `mapstructure:"placement"`
-	TLSCACert         string     `mapstructure:"tls_ca_cert"`
-	TLSClientCert     string     `mapstructure:"tls_client_cert"`
-	TLSClientKey      string     `mapstructure:"tls_client_key"`
-	TLSHostname       string     `mapstructure:"tls_hostname"`
-	MessageType       string     `mapstructure:"message_type"`
-	FormatVersion     uint       `mapstructure:"format_version"`
-	CreatedAt         *time.Time `mapstructure:"created_at"`
-	UpdatedAt         *time.Time `mapstructure:"updated_at"`
-	DeletedAt         *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name              string     `mapstructure:"name" json:"name"`
+	ResponseCondition string     `mapstructure:"response_condition" json:"response_condition"`
+	Format            string     `mapstructure:"format" json:"format"`
+	URL               string     `mapstructure:"url" json:"url"`
+	RequestMaxEntries uint       `mapstructure:"request_max_entries" json:"request_max_entries"`
+	RequestMaxBytes   uint       `mapstructure:"request_max_bytes" json:"request_max_bytes"`
+	ContentType       string     `mapstructure:"content_type" json:"content_type"`
+	HeaderName        string     `mapstructure:"header_name" json:"header_name"`
+	HeaderValue       string     `mapstructure:"header_value" json:"header_value"`
+	Method            string     `mapstructure:"method" json:"method"`
+	JSONFormat        string     `mapstructure:"json_format" json:"json_format"`
+	Placement         string     `mapstructure:"placement" json:"placement"`
+	TLSCACert         string     `mapstructure:"tls_ca_cert" json:"tls_ca_cert"`
+	TLSClientCert     string     `mapstructure:"tls_client_cert" json:"tls_client_cert"`
+	TLSClientKey      string     `mapstructure:"tls_client_key" json:"tls_client_key"`
+	TLSHostname       string     `mapstructure:"tls_hostname" json:"tls_hostname"`
+	MessageType       string     `mapstructure:"message_type" json:"message_type"`
+	FormatVersion     uint       `mapstructure:"format_version" json:"format_version"`
+	CreatedAt         *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // httpsByName is a sortable list of HTTPS logs.
@@ -78,6 +79,28 @@ func (c *Client) ListHTTPS(i *ListHTTPSInput) ([]*HTTPS, error) {
 	return https, nil
 }
 
+// validHTTPSMethod reports whether m is an HTTP method the HTTPS logging
+// endpoint can send log batches with. An empty value selects POST.
+func validHTTPSMethod(m string) bool {
+	switch m {
+	case "", http.MethodPost, http.MethodPut:
+		return true
+	default:
+		return false
+	}
+}
+
+// validJSONFormat reports whether f is a supported json_format value: 0 (none),
+// 1 (array of JSON objects) or 2 (newline delimited JSON).
+func validJSONFormat(f string) bool {
+	switch f {
+	case "", "0", "1", "2":
+		return true
+	default:
+		return false
+	}
+}
+
 // CreateHTTPSInput is used as input to the CreateHTTPS function.
 type CreateHTTPSInput struct {
 	// ServiceID is the ID of the service (required).
@@ -116,6 +139,22 @@ func (c *Client) CreateHTTPS(i *CreateHTTPSInput) (*HTTPS, error) {
 		return nil, ErrMissingServiceVersion
 	}
 
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	if !validHTTPSMethod(i.Method) {
+		return nil, ErrInvalidHTTPSMethod
+	}
+
+	if !validJSONFormat(i.JSONFormat) {
+		return nil, ErrInvalidJSONFormat
+	}
+
+	if !validMessageType(i.MessageType) {
+		return nil, ErrInvalidMessageType
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/logging/https", i.ServiceID, i.ServiceVersion)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
@@ -211,6 +250,18 @@ func (c *Client) UpdateHTTPS(i *UpdateHTTPSInput) (*HTTPS, error) {
 		return nil, ErrMissingName
 	}
 
+	if i.Method != nil && !validHTTPSMethod(*i.Method) {
+		return nil, ErrInvalidHTTPSMethod
+	}
+
+	if i.JSONFormat != nil && !validJSONFormat(*i.JSONFormat) {
+		return nil, ErrInvalidJSONFormat
+	}
+
+	if i.MessageType != nil && !validMessageType(*i.MessageType) {
+		return nil, ErrInvalidMessageType
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/logging/https/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
@@ -272,6 +272,35 @@ func TestClient_CreateHTTPS_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateHTTPS(&CreateHTTPSInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateHTTPS(&CreateHTTPSInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Method:         "GET",
+	})
+	if err != ErrInvalidHTTPSMethod {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateHTTPS(&CreateHTTPSInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		JSONFormat:     "3",
+	})
+	if err != ErrInvalidJSONFormat {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetHTTPS_validation(t *testing.T) {
@@ -326,6 +355,26 @@ func TestClient_UpdateHTTPS_validation(t *testing.T) {
 	if err != ErrMissingName {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.UpdateHTTPS(&UpdateHTTPSInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Method:         String("PATCH"),
+	})
+	if err != ErrInvalidHTTPSMethod {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateHTTPS(&UpdateHTTPSInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		JSONFormat:     String("json"),
+	})
+	if err != ErrInvalidJSONFormat {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_DeleteHTTPS_validation(t *testing.T) {
@@ -9,31 +9,31 @@ import (
 
 // Kafka represents a kafka response from the Fastly API.
 type Kafka struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name              string     `mapstructure:"name"`
-	Brokers           string     `mapstructure:"brokers"`
-	Topic             string     `mapstructure:"topic"`
-	RequiredACKs      string     `mapstructure:"required_acks"`
-	UseTLS            bool       `mapstructure:"use_tls"`
-	CompressionCodec  string     `mapstructure:"compression_codec"`
-	Format            string     `mapstructure:"format"`
-	FormatVersion     uint       `mapstructure:"format_version"`
-	ResponseCondition string     `mapstructure:"response_condition"`
-	Placement         string     `mapstructure:"placement"`
-	TLSCACert         string     `mapstructure:"tls_ca_cert"`
-	TLSHostname       string     `mapstructure:"tls_hostname"`
-	TLSClientCert     string     `mapstructure:"tls_client_cert"`
-	TLSClientKey      string     `mapstructure:"tls_client_key"`
-	ParseLogKeyvals   bool       `mapstructure:"parse_log_keyvals"`
-	RequestMaxBytes   uint       `mapstructure:"request_max_bytes"`
-	AuthMethod        string     `mapstructure:"auth_method"`
-	User              string     `mapstructure:"user"`
-	Password          string     `mapstructure:"password"`
-	CreatedAt         *time.Time `mapstructure:"created_at"`
-	UpdatedAt         *time.Time `mapstructure:"updated_at"`
-	DeletedAt         *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name              string     `mapstructure:"name" json:"name"`
+	Brokers           string     `mapstructure:"brokers" json:"brokers"`
+	Topic             string     `mapstructure:"topic" json:"topic"`
+	RequiredACKs      string     `mapstructure:"required_acks" json:"required_acks"`
+	UseTLS            bool       `mapstructure:"use_tls" json:"use_tls"`
+	CompressionCodec  string     `mapstructure:"compression_codec" json:"compression_codec"`
+	Format            string     `mapstructure:"format" json:"format"`
+	FormatVersion     uint       `mapstructure:"format_version" json:"format_version"`
+	ResponseCondition string     `mapstructure:"response_condition" json:"response_condition"`
+	Placement         string     `mapstructure:"placement" json:"placement"`
+	TLSCACert         string     `mapstructure:"tls_ca_cert" json:"tls_ca_cert"`
+	TLSHostname       string     `mapstructure:"tls_hostname" json:"tls_hostname"`
+	TLSClientCert     string     `mapstructure:"tls_client_cert" json:"tls_client_cert"`
+	TLSClientKey      string     `mapstructure:"tls_client_key" json:"tls_client_key"`
+	ParseLogKeyvals   bool       `mapstructure:"parse_log_keyvals" json:"parse_log_keyvals"`
+	RequestMaxBytes   uint       `mapstructure:"request_max_bytes" json:"request_max_bytes"`
+	AuthMethod        string     `mapstructure:"auth_method" json:"auth_method"`
+	User              string     `mapstructure:"user" json:"user"`
+	Password          string     `mapstructure:"password" json:"password"`
+	CreatedAt         *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // kafkaByName is a sortable list of kafkas.
@@ -79,6 +79,28 @@ func (c *Client) ListKafkas(i *ListKafkasInput) ([]*Kafka, error) {
 	return k, nil
 }
 
+// validKafkaRequiredACKs reports whether a is an accepted required_acks value.
+// An empty value leaves the API default in place.
+func validKafkaRequiredACKs(a string) bool {
+	switch a {
+	case "", "-1", "0", "1":
+		return true
+	default:
+		return false
+	}
+}
+
+// validKafkaAuthMethod reports whether m is a supported SASL authentication
+// method. An empty value disables SASL authentication.
+func validKafkaAuthMethod(m string) bool {
+	switch m {
+	case "", "plain", "scram-sha-256", "scram-sha-512":
+		return true
+	default:
+		return false
+	}
+}
+
 // CreateKafkaInput is used as input to the CreateKafka function.
 type CreateKafkaInput struct {
 	// ServiceID is the ID of the service (required).
@@ -118,6 +140,22 @@ func (c *Client) CreateKafka(i *CreateKafkaInput) (*Kafka, error) {
 		return nil, ErrMissingServiceVersion
 	}
 
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	if !validKafkaRequiredACKs(i.RequiredACKs) {
+		return nil, ErrInvalidRequiredACKs
+	}
+
+	if !validKafkaAuthMethod(i.AuthMethod) {
+		return nil, ErrInvalidAuthMethod
+	}
+
+	if i.AuthMethod == "" && (i.User != "" || i.Password != "") {
+		return nil, ErrMissingAuthMethod
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/logging/kafka", i.ServiceID, i.ServiceVersion)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
@@ -216,6 +254,19 @@ func (c *Client) UpdateKafka(i *UpdateKafkaInput) (*Kafka, error) {
 		return nil, ErrMissingName
 	}
 
+	if i.RequiredACKs != nil && !validKafkaRequiredACKs(*i.RequiredACKs) {
+		return nil, ErrInvalidRequiredACKs
+	}
+
+	if i.AuthMethod != nil && !validKafkaAuthMethod(*i.AuthMethod) {
+		return nil, ErrInvalidAuthMethod
+	}
+
+	if i.AuthMethod != nil && *i.AuthMethod == "" &&
+		((i.User != nil && *i.User != "") || (i.Password != nil && *i.Password != "")) {
+		return nil, ErrMissingAuthMethod
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/logging/kafka/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
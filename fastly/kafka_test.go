@@ -272,6 +272,46 @@ func TestClient_CreateKafka_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateKafka(&CreateKafkaInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateKafka(&CreateKafkaInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		RequiredACKs:   "2",
+	})
+	if err != ErrInvalidRequiredACKs {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateKafka(&CreateKafkaInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		AuthMethod:     "gssapi",
+	})
+	if err != ErrInvalidAuthMethod {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateKafka(&CreateKafkaInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		User:           "foobar",
+		Password:       "deadbeef",
+	})
+	if err != ErrMissingAuthMethod {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetKafka_validation(t *testing.T) {
@@ -326,6 +366,27 @@ func TestClient_UpdateKafka_validation(t *testing.T) {
 	if err != ErrMissingName {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.UpdateKafka(&UpdateKafkaInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		RequiredACKs:   String("all"),
+	})
+	if err != ErrInvalidRequiredACKs {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateKafka(&UpdateKafkaInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		AuthMethod:     String(""),
+		User:           String("foobar"),
+	})
+	if err != ErrMissingAuthMethod {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_DeleteKafka_validation(t *testing.T) {
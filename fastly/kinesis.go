@@ -9,22 +9,22 @@ import (
 
 // Kinesis represents a Kinesis response from the Fastly API.
 type Kinesis struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name              string     `mapstructure:"name"`
-	StreamName        string     `mapstructure:"topic"`
-	Region            string     `mapstructure:"region"`
-	AccessKey         string     `mapstructure:"access_key"`
-	SecretKey         string     `mapstructure:"secret_key"`
-	IAMRole           string     `mapstructure:"iam_role"`
-	Format            string     `mapstructure:"format"`
-	FormatVersion     uint       `mapstructure:"format_version"`
-	ResponseCondition string     `mapstructure:"response_condition"`
-	Placement         string     `mapstructure:"placement"`
-	CreatedAt         *time.Time `mapstructure:"created_at"`
-	UpdatedAt         *time.Time `mapstructure:"updated_at"`
-	DeletedAt         *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name              string     `mapstructure:"name" json:"name"`
+	StreamName        string     `mapstructure:"topic" json:"topic"`
+	Region            string     `mapstructure:"region" json:"region"`
+	AccessKey         string     `mapstructure:"access_key" json:"access_key"`
+	SecretKey         string     `mapstructure:"secret_key" json:"secret_key"`
+	IAMRole           string     `mapstructure:"iam_role" json:"iam_role"`
+	Format            string     `mapstructure:"format" json:"format"`
+	FormatVersion     uint       `mapstructure:"format_version" json:"format_version"`
+	ResponseCondition string     `mapstructure:"response_condition" json:"response_condition"`
+	Placement         string     `mapstructure:"placement" json:"placement"`
+	CreatedAt         *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // kinesisByName is a sortable list of Kinesis.
@@ -90,14 +90,19 @@ type CreateKinesisInput struct {
 	Placement         string `url:"placement,omitempty"`
 }
 
-// CreateKinesis creates a new Fastly Kinesis.
+// CreateKinesis creates a new Fastly Kinesis. Exactly one of IAMRole or the
+// AccessKey and SecretKey pair must be provided.
 func (c *Client) CreateKinesis(i *CreateKinesisInput) (*Kinesis, error) {
-	if i.ServiceID == "" {
-		return nil, ErrMissingServiceID
+	if err := validateServiceVersionName(i.ServiceID, i.ServiceVersion, i.Name); err != nil {
+		return nil, err
 	}
 
-	if i.ServiceVersion == 0 {
-		return nil, ErrMissingServiceVersion
+	if i.IAMRole != "" && (i.AccessKey != "" || i.SecretKey != "") {
+		return nil, ErrKinesisCredentialsConflict
+	}
+
+	if i.IAMRole == "" && (i.AccessKey == "" || i.SecretKey == "") {
+		return nil, ErrMissingKinesisCredentials
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/logging/kinesis", i.ServiceID, i.ServiceVersion)
@@ -189,6 +194,11 @@ func (c *Client) UpdateKinesis(i *UpdateKinesisInput) (*Kinesis, error) {
 		return nil, ErrMissingName
 	}
 
+	if i.IAMRole != nil && *i.IAMRole != "" &&
+		((i.AccessKey != nil && *i.AccessKey != "") || (i.SecretKey != nil && *i.SecretKey != "")) {
+		return nil, ErrKinesisCredentialsConflict
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/logging/kinesis/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
@@ -9,21 +9,21 @@ import (
 
 // Logentries represents a logentries response from the Fastly API.
 type Logentries struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name              string     `mapstructure:"name"`
-	Port              uint       `mapstructure:"port"`
-	UseTLS            bool       `mapstructure:"use_tls"`
-	Token             string     `mapstructure:"token"`
-	Format            string     `mapstructure:"format"`
-	FormatVersion     uint       `mapstructure:"format_version"`
-	ResponseCondition string     `mapstructure:"response_condition"`
-	Region            string     `mapstructure:"region"`
-	CreatedAt         *time.Time `mapstructure:"created_at"`
-	UpdatedAt         *time.Time `mapstructure:"updated_at"`
-	DeletedAt         *time.Time `mapstructure:"deleted_at"`
-	Placement         string     `mapstructure:"placement"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name              string     `mapstructure:"name" json:"name"`
+	Port              uint       `mapstructure:"port" json:"port"`
+	UseTLS            bool       `mapstructure:"use_tls" json:"use_tls"`
+	Token             string     `mapstructure:"token" json:"token"`
+	Format            string     `mapstructure:"format" json:"format"`
+	FormatVersion     uint       `mapstructure:"format_version" json:"format_version"`
+	ResponseCondition string     `mapstructure:"response_condition" json:"response_condition"`
+	Region            string     `mapstructure:"region" json:"region"`
+	CreatedAt         *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
+	Placement         string     `mapstructure:"placement" json:"placement"`
 }
 
 // logentriesByName is a sortable list of logentries.
@@ -90,12 +90,8 @@ type CreateLogentriesInput struct {
 
 // CreateLogentries creates a new Fastly logentries.
 func (c *Client) CreateLogentries(i *CreateLogentriesInput) (*Logentries, error) {
-	if i.ServiceID == "" {
-		return nil, ErrMissingServiceID
-	}
-
-	if i.ServiceVersion == 0 {
-		return nil, ErrMissingServiceVersion
+	if err := validateServiceVersionName(i.ServiceID, i.ServiceVersion, i.Name); err != nil {
+		return nil, err
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/logging/logentries", i.ServiceID, i.ServiceVersion)
@@ -125,16 +121,8 @@ type GetLogentriesInput struct {
 
 // GetLogentries gets the logentries configuration with the given parameters.
 func (c *Client) GetLogentries(i *GetLogentriesInput) (*Logentries, error) {
-	if i.ServiceID == "" {
-		return nil, ErrMissingServiceID
-	}
-
-	if i.ServiceVersion == 0 {
-		return nil, ErrMissingServiceVersion
-	}
-
-	if i.Name == "" {
-		return nil, ErrMissingName
+	if err := validateServiceVersionName(i.ServiceID, i.ServiceVersion, i.Name); err != nil {
+		return nil, err
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/logging/logentries/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
@@ -174,16 +162,8 @@ type UpdateLogentriesInput struct {
 
 // UpdateLogentries updates a specific logentries.
 func (c *Client) UpdateLogentries(i *UpdateLogentriesInput) (*Logentries, error) {
-	if i.ServiceID == "" {
-		return nil, ErrMissingServiceID
-	}
-
-	if i.ServiceVersion == 0 {
-		return nil, ErrMissingServiceVersion
-	}
-
-	if i.Name == "" {
-		return nil, ErrMissingName
+	if err := validateServiceVersionName(i.ServiceID, i.ServiceVersion, i.Name); err != nil {
+		return nil, err
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/logging/logentries/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
@@ -213,16 +193,8 @@ type DeleteLogentriesInput struct {
 
 // DeleteLogentries deletes the given logentries version.
 func (c *Client) DeleteLogentries(i *DeleteLogentriesInput) error {
-	if i.ServiceID == "" {
-		return ErrMissingServiceID
-	}
-
-	if i.ServiceVersion == 0 {
-		return ErrMissingServiceVersion
-	}
-
-	if i.Name == "" {
-		return ErrMissingName
+	if err := validateServiceVersionName(i.ServiceID, i.ServiceVersion, i.Name); err != nil {
+		return err
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/logging/logentries/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
@@ -8,7 +8,7 @@ import (
 type (
 	// ManagedLogging represents a managed logging endpoint for a service.
 	ManagedLogging struct {
-		ServiceID string `mapstructure:"service_id"`
+		ServiceID string `mapstructure:"service_id" json:"service_id"`
 	}
 
 	// ManagedLoggingKind type represents multiple kinds of log streams the
@@ -9,19 +9,19 @@ import (
 
 // NewRelic represents a newrelic response from the Fastly API.
 type NewRelic struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name              string     `mapstructure:"name"`
-	Token             string     `mapstructure:"token"`
-	Format            string     `mapstructure:"format"`
-	FormatVersion     uint       `mapstructure:"format_version"`
-	ResponseCondition string     `mapstructure:"response_condition"`
-	Placement         string     `mapstructure:"placement"`
-	Region            string     `mapstructure:"region"`
-	CreatedAt         *time.Time `mapstructure:"created_at"`
-	UpdatedAt         *time.Time `mapstructure:"updated_at"`
-	DeletedAt         *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name              string     `mapstructure:"name" json:"name"`
+	Token             string     `mapstructure:"token" json:"token"`
+	Format            string     `mapstructure:"format" json:"format"`
+	FormatVersion     uint       `mapstructure:"format_version" json:"format_version"`
+	ResponseCondition string     `mapstructure:"response_condition" json:"response_condition"`
+	Placement         string     `mapstructure:"placement" json:"placement"`
+	Region            string     `mapstructure:"region" json:"region"`
+	CreatedAt         *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // newrelicByName is a sortable list of newrelic.
@@ -67,6 +67,17 @@ func (c *Client) ListNewRelic(i *ListNewRelicInput) ([]*NewRelic, error) {
 	return n, nil
 }
 
+// validNewRelicRegion reports whether r is a New Relic region supported by
+// Fastly. An empty value leaves the API default in place.
+func validNewRelicRegion(r string) bool {
+	switch r {
+	case "", "us", "eu":
+		return true
+	default:
+		return false
+	}
+}
+
 // CreateNewRelicInput is used as input to the CreateNewRelic function.
 type CreateNewRelicInput struct {
 	// ServiceID is the ID of the service (required).
@@ -86,12 +97,12 @@ type CreateNewRelicInput struct {
 
 // CreateNewRelic creates a new Fastly newrelic.
 func (c *Client) CreateNewRelic(i *CreateNewRelicInput) (*NewRelic, error) {
-	if i.ServiceID == "" {
-		return nil, ErrMissingServiceID
+	if err := validateServiceVersionName(i.ServiceID, i.ServiceVersion, i.Name); err != nil {
+		return nil, err
 	}
 
-	if i.ServiceVersion == 0 {
-		return nil, ErrMissingServiceVersion
+	if !validNewRelicRegion(i.Region) {
+		return nil, ErrInvalidRegion
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/logging/newrelic", i.ServiceID, i.ServiceVersion)
@@ -180,6 +191,10 @@ func (c *Client) UpdateNewRelic(i *UpdateNewRelicInput) (*NewRelic, error) {
 		return nil, ErrMissingName
 	}
 
+	if i.Region != nil && !validNewRelicRegion(*i.Region) {
+		return nil, ErrInvalidRegion
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/logging/newrelic/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
@@ -285,6 +285,15 @@ func TestClient_CreateNewRelic_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateNewRelic(&CreateNewRelicInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetNewRelic_validation(t *testing.T) {
@@ -339,6 +348,16 @@ func TestClient_UpdateNewRelic_validation(t *testing.T) {
 	if err != ErrMissingName {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.UpdateNewRelic(&UpdateNewRelicInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Region:         String("zz"),
+	})
+	if err != ErrInvalidRegion {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_DeleteNewRelic_validation(t *testing.T) {
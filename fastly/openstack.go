@@ -9,28 +9,28 @@ import (
 
 // Openstack represents a Openstack response from the Fastly API.
 type Openstack struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name              string     `mapstructure:"name"`
-	User              string     `mapstructure:"user"`
-	AccessKey         string     `mapstructure:"access_key"`
-	BucketName        string     `mapstructure:"bucket_name"`
-	URL               string     `mapstructure:"url"`
-	Path              string     `mapstructure:"path"`
-	Placement         string     `mapstructure:"placement"`
-	Period            uint       `mapstructure:"period"`
-	CompressionCodec  string     `mapstructure:"compression_codec"`
-	GzipLevel         uint       `mapstructure:"gzip_level"`
-	Format            string     `mapstructure:"format"`
-	FormatVersion     uint       `mapstructure:"format_version"`
-	ResponseCondition string     `mapstructure:"response_condition"`
-	MessageType       string     `mapstructure:"message_type"`
-	TimestampFormat   string     `mapstructure:"timestamp_format"`
-	PublicKey         string     `mapstructure:"public_key"`
-	CreatedAt         *time.Time `mapstructure:"created_at"`
-	UpdatedAt         *time.Time `mapstructure:"updated_at"`
-	DeletedAt         *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name              string     `mapstructure:"name" json:"name"`
+	User              string     `mapstructure:"user" json:"user"`
+	AccessKey         string     `mapstructure:"access_key" json:"access_key"`
+	BucketName        string     `mapstructure:"bucket_name" json:"bucket_name"`
+	URL               string     `mapstructure:"url" json:"url"`
+	Path              string     `mapstructure:"path" json:"path"`
+	Placement         string     `mapstructure:"placement" json:"placement"`
+	Period            uint       `mapstructure:"period" json:"period"`
+	CompressionCodec  string     `mapstructure:"compression_codec" json:"compression_codec"`
+	GzipLevel         uint       `mapstructure:"gzip_level" json:"gzip_level"`
+	Format            string     `mapstructure:"format" json:"format"`
+	FormatVersion     uint       `mapstructure:"format_version" json:"format_version"`
+	ResponseCondition string     `mapstructure:"response_condition" json:"response_condition"`
+	MessageType       string     `mapstructure:"message_type" json:"message_type"`
+	TimestampFormat   string     `mapstructure:"timestamp_format" json:"timestamp_format"`
+	PublicKey         string     `mapstructure:"public_key" json:"public_key"`
+	CreatedAt         *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // openstacksByName is a sortable list of Openstack.
@@ -104,12 +104,12 @@ type CreateOpenstackInput struct {
 
 // CreateOpenstack creates a new Fastly Openstack.
 func (c *Client) CreateOpenstack(i *CreateOpenstackInput) (*Openstack, error) {
-	if i.ServiceID == "" {
-		return nil, ErrMissingServiceID
+	if err := validateServiceVersionName(i.ServiceID, i.ServiceVersion, i.Name); err != nil {
+		return nil, err
 	}
 
-	if i.ServiceVersion == 0 {
-		return nil, ErrMissingServiceVersion
+	if i.GzipLevel != 0 && i.CompressionCodec != "" {
+		return nil, ErrGzipLevelWithCompressionCodec
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/logging/openstack", i.ServiceID, i.ServiceVersion)
@@ -207,6 +207,10 @@ func (c *Client) UpdateOpenstack(i *UpdateOpenstackInput) (*Openstack, error) {
 		return nil, ErrMissingName
 	}
 
+	if i.GzipLevel != nil && *i.GzipLevel != 0 && i.CompressionCodec != nil && *i.CompressionCodec != "" {
+		return nil, ErrGzipLevelWithCompressionCodec
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/logging/openstack/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
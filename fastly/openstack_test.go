@@ -390,6 +390,26 @@ func TestClient_CreateOpenstack_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateOpenstack(&CreateOpenstackInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateOpenstack(&CreateOpenstackInput{
+		ServiceID:        "foo",
+		ServiceVersion:   1,
+		Name:             "test",
+		CompressionCodec: "snappy",
+		GzipLevel:        8,
+	})
+	if err != ErrGzipLevelWithCompressionCodec {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetOpenstack_validation(t *testing.T) {
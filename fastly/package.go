@@ -1,20 +1,26 @@
 package fastly
 
 import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"time"
 )
 
 // Package is a container for data returned about a package.
 type Package struct {
 	ID             string
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
 	Metadata       PackageMetadata
-	CreatedAt      *time.Time `mapstructure:"created_at"`
-	UpdatedAt      *time.Time `mapstructure:"updated_at"`
-	DeletedAt      *time.Time `mapstructure:"deleted_at"`
+	CreatedAt      *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt      *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt      *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // Package is a container for metadata returned about a package.
@@ -32,10 +38,10 @@ type PackageMetadata struct {
 // GetPackageInput is used as input to the GetPackage function.
 type GetPackageInput struct {
 	// ServiceID is the ID of the service (required).
-	ServiceID string `mapstructure:"service_id"`
+	ServiceID string `mapstructure:"service_id" json:"service_id"`
 
 	// ServiceVersion is the specific configuration version (required).
-	ServiceVersion int `mapstructure:"version"`
+	ServiceVersion int `mapstructure:"version" json:"version"`
 }
 
 // GetPackage retrieves  package information for the given service and version.
@@ -56,16 +62,27 @@ func (c *Client) GetPackage(i *GetPackageInput) (*Package, error) {
 // UpdatePackageInput is used as input to the UpdatePackage function.
 type UpdatePackageInput struct {
 	// ServiceID is the ID of the service (required).
-	ServiceID string `mapstructure:"service_id"`
+	ServiceID string `mapstructure:"service_id" json:"service_id"`
 
 	// ServiceVersion is the specific configuration version (required).
-	ServiceVersion int `mapstructure:"version"`
+	ServiceVersion int `mapstructure:"version" json:"version"`
 
 	// PackagePath is the local filesystem path to the package to upload.
 	PackagePath string
+
+	// Package is the content of the package to upload, used when PackagePath
+	// is not set.
+	Package io.Reader
+
+	// Timeout, when positive, limits how long the request may take. See
+	// RequestOptions.Timeout.
+	Timeout time.Duration
 }
 
-// UpdatePackage updates a package for a specific version.
+// UpdatePackage uploads a Compute@Edge package (a gzipped tar archive) for a
+// specific version. The package is streamed to the API rather than read into
+// memory; only its first entry is checked to be a gzipped tar archive before
+// it is uploaded.
 func (c *Client) UpdatePackage(i *UpdatePackageInput) (*Package, error) {
 
 	urlPath, err := MakePackagePath(i.ServiceID, i.ServiceVersion)
@@ -73,7 +90,35 @@ func (c *Client) UpdatePackage(i *UpdatePackageInput) (*Package, error) {
 		return nil, err
 	}
 
-	resp, err := c.PutFormFile(urlPath, i.PackagePath, "package", nil)
+	var r io.Reader
+	fileName := "package.tar.gz"
+	switch {
+	case i.PackagePath != "":
+		f, err := os.Open(filepath.Clean(i.PackagePath))
+		if err != nil {
+			return nil, fmt.Errorf("error reading file: %v", err)
+		}
+		defer f.Close()
+		r = f
+		fileName = filepath.Base(i.PackagePath)
+	case i.Package != nil:
+		r = i.Package
+	default:
+		return nil, ErrMissingPackage
+	}
+
+	br := bufio.NewReaderSize(r, packagePeekSize)
+	if !isGzipTar(peekPackage(br)) {
+		return nil, ErrInvalidPackage
+	}
+
+	if err := c.checkServiceType(i.ServiceID, ServiceTypeWasm); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.PutMultipart(urlPath, nil, map[string]FormFile{
+		"package": {Name: fileName, Reader: br},
+	}, &RequestOptions{Timeout: i.Timeout})
 	if err != nil {
 		return nil, err
 	}
@@ -81,6 +126,30 @@ func (c *Client) UpdatePackage(i *UpdatePackageInput) (*Package, error) {
 	return PopulatePackage(resp.Body)
 }
 
+// packagePeekSize is how much of a package UpdatePackage reads ahead to check
+// it. The first tar header is 512 bytes before compression, so this is ample.
+const packagePeekSize = 64 * 1024
+
+// peekPackage returns the start of the package without consuming it. A
+// package shorter than packagePeekSize is returned whole.
+func peekPackage(r *bufio.Reader) []byte {
+	b, _ := r.Peek(packagePeekSize)
+	return b
+}
+
+// isGzipTar reports whether b, which may be only the start of a file, is a
+// gzip-compressed tar archive with at least one entry.
+func isGzipTar(b []byte) bool {
+	gz, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return false
+	}
+	defer gz.Close()
+
+	_, err = tar.NewReader(gz).Next()
+	return err == nil
+}
+
 // MakePackagePath ensures we create the correct REST path for referencing packages in the API.
 func MakePackagePath(ServiceID string, ServiceVersion int) (string, error) {
 	if ServiceID == "" {
@@ -1,6 +1,11 @@
 package fastly
 
 import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -132,4 +137,79 @@ func TestClient_UpdatePackage_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.UpdatePackage(&UpdatePackageInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+	})
+	if err != ErrMissingPackage {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdatePackage(&UpdatePackageInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Package:        strings.NewReader("not a package"),
+	})
+	if err != ErrInvalidPackage {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_UpdatePackage_gzipNotTar(t *testing.T) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	gz.Write([]byte("this is gzipped, but it is not a tar archive"))
+	gz.Close()
+
+	_, err := testClient.UpdatePackage(&UpdatePackageInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Package:        &buf,
+	})
+	if err != ErrInvalidPackage {
+		t.Errorf("bad error: %v", err)
+	}
+}
+
+func TestClient_UpdatePackage_reader(t *testing.T) {
+	t.Parallel()
+
+	b, err := ioutil.ReadFile("test_assets/package/valid.tar.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/service/foo/version/1/package" {
+			t.Errorf("bad request: %s %s", r.Method, r.URL.Path)
+		}
+		f, fh, err := r.FormFile("package")
+		if err != nil {
+			t.Error(err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		defer f.Close()
+		got, _ := ioutil.ReadAll(f)
+		if !bytes.Equal(got, b) {
+			t.Error("uploaded package does not match")
+		}
+		if fh.Filename != "package.tar.gz" {
+			t.Errorf("bad filename: %q", fh.Filename)
+		}
+		w.Write([]byte(`{"service_id":"foo","version":1,"metadata":{"name":"pkg"}}`))
+	})
+
+	p, err := c.UpdatePackage(&UpdatePackageInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Package:        bytes.NewReader(b),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Metadata.Name != "pkg" {
+		t.Errorf("bad name: %q", p.Metadata.Name)
+	}
 }
@@ -0,0 +1,297 @@
+package fastly
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"reflect"
+
+	"github.com/peterhellberg/link"
+)
+
+// maxListPages bounds the number of pages a list function fetches, so that a
+// malformed response that always links to another page cannot loop forever.
+const maxListPages = 1000
+
+// getAllPages fetches path and then each page named by the rel="next" Link
+// header of the previous response, passing every body to decode, which must
+// close it. It returns ErrTooManyPages after maxListPages pages.
+func (c *Client) getAllPages(path string, decode func(io.ReadCloser) error) error {
+	var params map[string]string
+	for n := 0; ; n++ {
+		if n == maxListPages {
+			return ErrTooManyPages
+		}
+
+		resp, err := c.Get(path, &RequestOptions{Params: params})
+		if err != nil {
+			return err
+		}
+
+		var next string
+		for _, l := range link.ParseResponse(resp) {
+			if l.Rel == "next" {
+				next = l.URI
+			}
+		}
+
+		if err := decode(resp.Body); err != nil {
+			return err
+		}
+		if next == "" {
+			return nil
+		}
+
+		u, err := url.Parse(next)
+		if err != nil {
+			return fmt.Errorf("invalid next page link %q: %v", next, err)
+		}
+		path = u.Path
+		params = make(map[string]string)
+		for k, v := range u.Query() {
+			params[k] = v[0]
+		}
+	}
+}
+
+// jsonAPIPaginator walks a JSON:API list endpoint one page at a time,
+// following links.next until it is exhausted. It is shared by the typed
+// paginators for endpoints that use page[number]/page[size] pagination.
+type jsonAPIPaginator struct {
+	client *Client
+	ctx    context.Context
+	typ    reflect.Type
+
+	path   string
+	params map[string]string
+
+	started bool
+	next    string
+	err     error
+}
+
+// newJSONAPIPaginator returns a paginator that starts at path with params and
+// decodes each record as type t, which must be a pointer to a JSON:API struct.
+func (c *Client) newJSONAPIPaginator(ctx context.Context, path string, params map[string]string, t reflect.Type) *jsonAPIPaginator {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return &jsonAPIPaginator{
+		client: c,
+		ctx:    ctx,
+		typ:    t,
+		path:   path,
+		params: params,
+	}
+}
+
+// hasNext reports whether another page can be fetched.
+func (p *jsonAPIPaginator) hasNext() bool {
+	return p.err == nil && (!p.started || p.next != "")
+}
+
+// getNext fetches and decodes the next page.
+func (p *jsonAPIPaginator) getNext() ([]interface{}, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	if p.started && p.next == "" {
+		return nil, nil
+	}
+	if err := p.ctx.Err(); err != nil {
+		p.err = err
+		return nil, err
+	}
+
+	path, params := p.path, p.params
+	if p.started {
+		u, err := url.Parse(p.next)
+		if err != nil {
+			p.err = fmt.Errorf("invalid next page link %q: %v", p.next, err)
+			return nil, p.err
+		}
+		path = u.Path
+		params = make(map[string]string)
+		for k, v := range u.Query() {
+			params[k] = v[0]
+		}
+	}
+
+	resp, err := p.client.Get(path, &RequestOptions{
+		Context: p.ctx,
+		Params:  params,
+		Headers: map[string]string{
+			"Accept": "application/vnd.api+json", // this is required otherwise the filters don't work
+		},
+	})
+	if err != nil {
+		p.err = err
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, info, err := decodeJSONAPIList(resp.Body, p.typ)
+	if err != nil {
+		p.err = err
+		return nil, err
+	}
+
+	p.started = true
+	p.next = info.Links.Next
+	if len(data) == 0 {
+		// Guard against an endpoint that keeps linking to empty pages.
+		p.next = ""
+	}
+	return data, nil
+}
+
+// getNextInto fetches the next page and stores its records in out, which must
+// be a pointer to a slice of the paginator's record type.
+func (p *jsonAPIPaginator) getNextInto(out interface{}) error {
+	data, err := p.getNext()
+	if err != nil {
+		return err
+	}
+
+	records := reflect.MakeSlice(reflect.SliceOf(p.typ), len(data), len(data))
+	for i := range data {
+		v := reflect.ValueOf(data[i])
+		if v.Type() != p.typ {
+			return fmt.Errorf("unexpected response type: %T", data[i])
+		}
+		records.Index(i).Set(v)
+	}
+	reflect.ValueOf(out).Elem().Set(records)
+	return nil
+}
+
+// ListCustomTLSCertificatesPaginator iterates over the pages of custom TLS
+// certificates.
+type ListCustomTLSCertificatesPaginator struct {
+	p *jsonAPIPaginator
+}
+
+// NewListCustomTLSCertificatesPaginator returns a paginator over the
+// certificates matching i. Cancelling ctx stops the iteration.
+func (c *Client) NewListCustomTLSCertificatesPaginator(ctx context.Context, i *ListCustomTLSCertificatesInput) *ListCustomTLSCertificatesPaginator {
+	return &ListCustomTLSCertificatesPaginator{
+		p: c.newJSONAPIPaginator(ctx, "/tls/certificates", i.formatFilters(), reflect.TypeOf(new(CustomTLSCertificate))),
+	}
+}
+
+// HasNext returns a boolean indicating whether more pages are available.
+func (p *ListCustomTLSCertificatesPaginator) HasNext() bool {
+	return p.p.hasNext()
+}
+
+// GetNext retrieves data in the next page.
+func (p *ListCustomTLSCertificatesPaginator) GetNext() ([]*CustomTLSCertificate, error) {
+	var cc []*CustomTLSCertificate
+	if err := p.p.getNextInto(&cc); err != nil {
+		return nil, err
+	}
+	return cc, nil
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (p *ListCustomTLSCertificatesPaginator) Err() error {
+	return p.p.err
+}
+
+// ListBulkCertificatesPaginator iterates over the pages of bulk certificates.
+type ListBulkCertificatesPaginator struct {
+	p *jsonAPIPaginator
+}
+
+// NewListBulkCertificatesPaginator returns a paginator over the bulk
+// certificates matching i. Cancelling ctx stops the iteration.
+func (c *Client) NewListBulkCertificatesPaginator(ctx context.Context, i *ListBulkCertificatesInput) *ListBulkCertificatesPaginator {
+	return &ListBulkCertificatesPaginator{
+		p: c.newJSONAPIPaginator(ctx, "/tls/bulk/certificates", i.formatFilters(), reflect.TypeOf(new(BulkCertificate))),
+	}
+}
+
+// HasNext returns a boolean indicating whether more pages are available.
+func (p *ListBulkCertificatesPaginator) HasNext() bool {
+	return p.p.hasNext()
+}
+
+// GetNext retrieves data in the next page.
+func (p *ListBulkCertificatesPaginator) GetNext() ([]*BulkCertificate, error) {
+	var bc []*BulkCertificate
+	if err := p.p.getNextInto(&bc); err != nil {
+		return nil, err
+	}
+	return bc, nil
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (p *ListBulkCertificatesPaginator) Err() error {
+	return p.p.err
+}
+
+// GetAPIEventsPaginator iterates over the pages of events.
+type GetAPIEventsPaginator struct {
+	p *jsonAPIPaginator
+}
+
+// NewGetAPIEventsPaginator returns a paginator over the events matching i.
+// Unlike GetAPIEvents, it fetches one page per call to GetNext. Cancelling ctx
+// stops the iteration.
+func (c *Client) NewGetAPIEventsPaginator(ctx context.Context, i *GetAPIEventsFilterInput) *GetAPIEventsPaginator {
+	return &GetAPIEventsPaginator{
+		p: c.newJSONAPIPaginator(ctx, "/events", i.formatEventFilters(), reflect.TypeOf(new(Event))),
+	}
+}
+
+// HasNext returns a boolean indicating whether more pages are available.
+func (p *GetAPIEventsPaginator) HasNext() bool {
+	return p.p.hasNext()
+}
+
+// GetNext retrieves data in the next page.
+func (p *GetAPIEventsPaginator) GetNext() ([]*Event, error) {
+	var events []*Event
+	if err := p.p.getNextInto(&events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (p *GetAPIEventsPaginator) Err() error {
+	return p.p.err
+}
+
+// ListWAFRulesPaginator iterates over the pages of WAF rules.
+type ListWAFRulesPaginator struct {
+	p *jsonAPIPaginator
+}
+
+// NewListWAFRulesPaginator returns a paginator over the WAF rules matching i,
+// starting at i.PageNumber. Cancelling ctx stops the iteration.
+func (c *Client) NewListWAFRulesPaginator(ctx context.Context, i *ListWAFRulesInput) *ListWAFRulesPaginator {
+	return &ListWAFRulesPaginator{
+		p: c.newJSONAPIPaginator(ctx, "/waf/rules", i.formatFilters(), WAFRuleType),
+	}
+}
+
+// HasNext returns a boolean indicating whether more pages are available.
+func (p *ListWAFRulesPaginator) HasNext() bool {
+	return p.p.hasNext()
+}
+
+// GetNext retrieves data in the next page.
+func (p *ListWAFRulesPaginator) GetNext() ([]*WAFRule, error) {
+	var rules []*WAFRule
+	if err := p.p.getNextInto(&rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (p *ListWAFRulesPaginator) Err() error {
+	return p.p.err
+}
@@ -0,0 +1,151 @@
+package fastly
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClient_NewListCustomTLSCertificatesPaginator(t *testing.T) {
+	t.Parallel()
+
+	var base string
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tls/certificates" {
+			t.Errorf("bad path: %q", r.URL.Path)
+		}
+		switch page := r.URL.Query().Get("page[number]"); page {
+		case "1":
+			if got := r.URL.Query().Get("page[size]"); got != "1" {
+				t.Errorf("bad page size: %q", got)
+			}
+			fmt.Fprintf(w, `{"data":[{"id":"CERT_1","type":"tls_certificate"}],"links":{"next":"%s/tls/certificates?page%%5Bnumber%%5D=2&page%%5Bsize%%5D=1"}}`, base)
+		case "2":
+			w.Write([]byte(`{"data":[{"id":"CERT_2","type":"tls_certificate"}],"links":{}}`))
+		default:
+			t.Errorf("unexpected page: %q", page)
+		}
+	})
+	base = c.Address
+
+	p := c.NewListCustomTLSCertificatesPaginator(context.Background(), &ListCustomTLSCertificatesInput{
+		PageNumber: 1,
+		PageSize:   1,
+	})
+
+	var ids []string
+	for p.HasNext() {
+		cc, err := p.GetNext()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, cert := range cc {
+			ids = append(ids, cert.ID)
+		}
+	}
+	if p.Err() != nil {
+		t.Fatal(p.Err())
+	}
+	if len(ids) != 2 || ids[0] != "CERT_1" || ids[1] != "CERT_2" {
+		t.Errorf("bad ids: %v", ids)
+	}
+}
+
+func TestClient_NewGetAPIEventsPaginator_cancelled(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s", r.URL)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	p := c.NewGetAPIEventsPaginator(ctx, &GetAPIEventsFilterInput{})
+	if !p.HasNext() {
+		t.Fatal("expected a first page")
+	}
+	if _, err := p.GetNext(); err != context.Canceled {
+		t.Errorf("bad error: %v", err)
+	}
+	if p.HasNext() {
+		t.Error("expected iteration to stop")
+	}
+	if p.Err() != context.Canceled {
+		t.Errorf("bad Err: %v", p.Err())
+	}
+}
+
+func TestClient_NewListWAFRulesPaginator(t *testing.T) {
+	t.Parallel()
+
+	var base string
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/waf/rules" {
+			t.Errorf("bad path: %q", r.URL.Path)
+		}
+		switch page := r.URL.Query().Get("page[number]"); page {
+		case "1":
+			if got := r.URL.Query().Get("filter[publisher][in]"); got != "owasp" {
+				t.Errorf("bad filter: %q", got)
+			}
+			fmt.Fprintf(w, `{"data":[{"id":"RULE_1","type":"waf_rule"}],"links":{"next":"%s/waf/rules?filter%%5Bpublisher%%5D%%5Bin%%5D=owasp&page%%5Bnumber%%5D=2"}}`, base)
+		case "2":
+			w.Write([]byte(`{"data":[{"id":"RULE_2","type":"waf_rule"}],"links":{}}`))
+		default:
+			t.Errorf("unexpected page: %q", page)
+		}
+	})
+	base = c.Address
+
+	p := c.NewListWAFRulesPaginator(context.Background(), &ListWAFRulesInput{
+		FilterPublishers: []string{"owasp"},
+		PageNumber:       1,
+	})
+
+	var ids []string
+	for p.HasNext() {
+		rules, err := p.GetNext()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, r := range rules {
+			ids = append(ids, r.ID)
+		}
+	}
+	if len(ids) != 2 || ids[0] != "RULE_1" || ids[1] != "RULE_2" {
+		t.Errorf("bad ids: %v", ids)
+	}
+}
+
+func TestClient_ListAllWAFVersions_pages(t *testing.T) {
+	t.Parallel()
+
+	var base string
+	var requests int
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch page := r.URL.Query().Get("page[number]"); page {
+		case "1":
+			fmt.Fprintf(w, `{"data":[{"id":"V1","type":"waf_firewall_version"}],"links":{"next":"%s/waf/firewalls/foo/versions?page%%5Bnumber%%5D=2"}}`, base)
+		case "2":
+			// An empty page ends the iteration even though it links onwards.
+			fmt.Fprintf(w, `{"data":[],"links":{"next":"%s/waf/firewalls/foo/versions?page%%5Bnumber%%5D=3"}}`, base)
+		default:
+			t.Errorf("unexpected page: %q", page)
+		}
+	})
+	base = c.Address
+
+	r, err := c.ListAllWAFVersions(&ListAllWAFVersionsInput{WAFID: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Items) != 1 || r.Items[0].ID != "V1" {
+		t.Errorf("bad items: %+v", r.Items)
+	}
+	if requests != 2 {
+		t.Errorf("bad request count: %d", requests)
+	}
+}
@@ -9,19 +9,19 @@ import (
 
 // Papertrail represents a papertrail response from the Fastly API.
 type Papertrail struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name              string     `mapstructure:"name"`
-	Address           string     `mapstructure:"address"`
-	Port              uint       `mapstructure:"port"`
-	Format            string     `mapstructure:"format"`
-	FormatVersion     uint       `mapstructure:"format_version"`
-	ResponseCondition string     `mapstructure:"response_condition"`
-	CreatedAt         *time.Time `mapstructure:"created_at"`
-	UpdatedAt         *time.Time `mapstructure:"updated_at"`
-	DeletedAt         *time.Time `mapstructure:"deleted_at"`
-	Placement         string     `mapstructure:"placement"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name              string     `mapstructure:"name" json:"name"`
+	Address           string     `mapstructure:"address" json:"address"`
+	Port              uint       `mapstructure:"port" json:"port"`
+	Format            string     `mapstructure:"format" json:"format"`
+	FormatVersion     uint       `mapstructure:"format_version" json:"format_version"`
+	ResponseCondition string     `mapstructure:"response_condition" json:"response_condition"`
+	CreatedAt         *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
+	Placement         string     `mapstructure:"placement" json:"placement"`
 }
 
 // papertrailsByName is a sortable list of papertrails.
@@ -89,12 +89,8 @@ type CreatePapertrailInput struct {
 
 // CreatePapertrail creates a new Fastly papertrail.
 func (c *Client) CreatePapertrail(i *CreatePapertrailInput) (*Papertrail, error) {
-	if i.ServiceID == "" {
-		return nil, ErrMissingServiceID
-	}
-
-	if i.ServiceVersion == 0 {
-		return nil, ErrMissingServiceVersion
+	if err := validateServiceVersionName(i.ServiceID, i.ServiceVersion, i.Name); err != nil {
+		return nil, err
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/logging/papertrail", i.ServiceID, i.ServiceVersion)
@@ -124,16 +120,8 @@ type GetPapertrailInput struct {
 
 // GetPapertrail gets the papertrail configuration with the given parameters.
 func (c *Client) GetPapertrail(i *GetPapertrailInput) (*Papertrail, error) {
-	if i.ServiceID == "" {
-		return nil, ErrMissingServiceID
-	}
-
-	if i.ServiceVersion == 0 {
-		return nil, ErrMissingServiceVersion
-	}
-
-	if i.Name == "" {
-		return nil, ErrMissingName
+	if err := validateServiceVersionName(i.ServiceID, i.ServiceVersion, i.Name); err != nil {
+		return nil, err
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/logging/papertrail/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
@@ -174,16 +162,8 @@ type UpdatePapertrailInput struct {
 
 // UpdatePapertrail updates a specific papertrail.
 func (c *Client) UpdatePapertrail(i *UpdatePapertrailInput) (*Papertrail, error) {
-	if i.ServiceID == "" {
-		return nil, ErrMissingServiceID
-	}
-
-	if i.ServiceVersion == 0 {
-		return nil, ErrMissingServiceVersion
-	}
-
-	if i.Name == "" {
-		return nil, ErrMissingName
+	if err := validateServiceVersionName(i.ServiceID, i.ServiceVersion, i.Name); err != nil {
+		return nil, err
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/logging/papertrail/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
@@ -213,16 +193,8 @@ type DeletePapertrailInput struct {
 
 // DeletePapertrail deletes the given papertrail version.
 func (c *Client) DeletePapertrail(i *DeletePapertrailInput) error {
-	if i.ServiceID == "" {
-		return ErrMissingServiceID
-	}
-
-	if i.ServiceVersion == 0 {
-		return ErrMissingServiceVersion
-	}
-
-	if i.Name == "" {
-		return ErrMissingName
+	if err := validateServiceVersionName(i.ServiceID, i.ServiceVersion, i.Name); err != nil {
+		return err
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/logging/papertrail/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
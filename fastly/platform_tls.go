@@ -68,7 +68,7 @@ func (i *ListBulkCertificatesInput) formatFilters() map[string]string {
 	return result
 }
 
-// ListBulkCertificates list all certificates.
+// ListBulkCertificates lists all bulk certificates.
 func (c *Client) ListBulkCertificates(i *ListBulkCertificatesInput) ([]*BulkCertificate, error) {
 
 	p := "/tls/bulk/certificates"
@@ -106,7 +106,7 @@ type GetBulkCertificateInput struct {
 	ID string
 }
 
-// GetBulkCertificate retrieve a single certificate.
+// GetBulkCertificate retrieves a single bulk certificate.
 func (c *Client) GetBulkCertificate(i *GetBulkCertificateInput) (*BulkCertificate, error) {
 
 	if i.ID == "" {
@@ -130,13 +130,20 @@ func (c *Client) GetBulkCertificate(i *GetBulkCertificateInput) (*BulkCertificat
 
 // CreateBulkCertificateInput is used as input to the CreateBulkCertificate function.
 type CreateBulkCertificateInput struct {
-	CertBlob          string              `jsonapi:"attr,cert_blob"`
-	IntermediatesBlob string              `jsonapi:"attr,intermediates_blob"`
-	AllowUntrusted    bool                `jsonapi:"attr,allow_untrusted_root,omitempty"`
-	Configurations    []*TLSConfiguration `jsonapi:"relation,tls_configurations,tls_configuration"`
+	// CertBlob is the PEM-formatted certificate and is required.
+	CertBlob string `jsonapi:"attr,cert_blob"`
+	// IntermediatesBlob is the PEM-formatted chain of intermediate certificates
+	// and is required.
+	IntermediatesBlob string `jsonapi:"attr,intermediates_blob"`
+	AllowUntrusted    bool   `jsonapi:"attr,allow_untrusted_root,omitempty"`
+	// Configurations are the TLS configurations the certificate is served
+	// with. At least one is required.
+	Configurations []*TLSConfiguration `jsonapi:"relation,tls_configurations,tls_configuration"`
 }
 
-// CreateBulkCertificate create a TLS private key.
+// CreateBulkCertificate uploads a certificate and its intermediates. The
+// returned BulkCertificate lists the Domains taken from the certificate's SAN
+// entries and its NotAfter expiry, which can be used to schedule renewal.
 func (c *Client) CreateBulkCertificate(i *CreateBulkCertificateInput) (*BulkCertificate, error) {
 
 	if i.CertBlob == "" {
@@ -145,6 +152,9 @@ func (c *Client) CreateBulkCertificate(i *CreateBulkCertificateInput) (*BulkCert
 	if i.IntermediatesBlob == "" {
 		return nil, ErrMissingIntermediatesBlob
 	}
+	if len(i.Configurations) == 0 {
+		return nil, ErrMissingConfigurations
+	}
 
 	p := "/tls/bulk/certificates"
 
@@ -169,7 +179,7 @@ type UpdateBulkCertificateInput struct {
 	AllowUntrusted    bool   `jsonapi:"attr,allow_untrusted_root"`
 }
 
-// UpdateBulkCertificate replace a certificate with a newly reissued certificate.
+// UpdateBulkCertificate replaces a certificate with a newly reissued certificate.
 // By using this endpoint, the original certificate will cease to be used for future TLS handshakes.
 // Thus, only SAN entries that appear in the replacement certificate will become TLS enabled.
 // Any SAN entries that are missing in the replacement certificate will become disabled.
@@ -204,7 +214,7 @@ type DeleteBulkCertificateInput struct {
 	ID string
 }
 
-// DeleteBulkCertificate destroy a certificate. This disables TLS for all domains listed as SAN entries.
+// DeleteBulkCertificate destroys a certificate. This disables TLS for all domains listed as SAN entries.
 func (c *Client) DeleteBulkCertificate(i *DeleteBulkCertificateInput) error {
 	if i.ID == "" {
 		return ErrMissingID
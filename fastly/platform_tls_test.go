@@ -91,6 +91,21 @@ func TestClient_CreateBulkCertificate_validation(t *testing.T) {
 	t.Parallel()
 
 	var err error
+	_, err = testClient.CreateBulkCertificate(&CreateBulkCertificateInput{
+		IntermediatesBlob: "-----BEGIN CERTIFICATE-----\n...\n-----END CERTIFICATE-----\n",
+	})
+	if err != ErrMissingCertBlob {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateBulkCertificate(&CreateBulkCertificateInput{
+		CertBlob:          "-----BEGIN CERTIFICATE-----\n...\n-----END CERTIFICATE-----\n",
+		IntermediatesBlob: "-----BEGIN CERTIFICATE-----\n...\n-----END CERTIFICATE-----\n",
+	})
+	if err != ErrMissingConfigurations {
+		t.Errorf("bad error: %s", err)
+	}
+
 	record(t, "platform_tls/create", func(c *Client) {
 		_, err = c.CreateBulkCertificate(&CreateBulkCertificateInput{
 			CertBlob:          "-----BEGIN CERTIFICATE-----\n...\n-----END CERTIFICATE-----\n",
@@ -21,6 +21,17 @@ const (
 // PoolType is a type of pool.
 type PoolType string
 
+// IsValid reports whether the type is one supported by the Fastly API. An
+// empty type is valid.
+func (t PoolType) IsValid() bool {
+	switch t {
+	case "", PoolTypeRandom, PoolTypeHash, PoolTypeClient:
+		return true
+	default:
+		return false
+	}
+}
+
 // PPoolType returns pointer to PoolType.
 func PPoolType(t PoolType) *PoolType {
 	pt := PoolType(t)
@@ -29,34 +40,34 @@ func PPoolType(t PoolType) *PoolType {
 
 // Pool represents a pool response from the Fastly API.
 type Pool struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	ID               string     `mapstructure:"id"`
-	Name             string     `mapstructure:"name"`
-	Comment          string     `mapstructure:"comment"`
-	Shield           string     `mapstructure:"shield"`
-	RequestCondition string     `mapstructure:"request_condition"`
-	MaxConnDefault   uint       `mapstructure:"max_conn_default"`
-	ConnectTimeout   uint       `mapstructure:"connect_timeout"`
-	FirstByteTimeout uint       `mapstructure:"first_byte_timeout"`
-	Quorum           uint       `mapstructure:"quorum"`
-	UseTLS           bool       `mapstructure:"use_tls"`
-	TLSCACert        string     `mapstructure:"tls_ca_cert"`
-	TLSCiphers       string     `mapstructure:"tls_ciphers"`
-	TLSClientKey     string     `mapstructure:"tls_client_key"`
-	TLSClientCert    string     `mapstructure:"tls_client_cert"`
-	TLSSNIHostname   string     `mapstructure:"tls_sni_hostname"`
-	TLSCheckCert     bool       `mapstructure:"tls_check_cert"`
-	TLSCertHostname  string     `mapstructure:"tls_cert_hostname"`
-	MinTLSVersion    string     `mapstructure:"min_tls_version"`
-	MaxTLSVersion    string     `mapstructure:"max_tls_version"`
-	Healthcheck      string     `mapstructure:"healthcheck"`
-	Type             PoolType   `mapstructure:"type"`
-	OverrideHost     string     `mapstructure:"override_host"`
-	CreatedAt        *time.Time `mapstructure:"created_at"`
-	DeletedAt        *time.Time `mapstructure:"deleted_at"`
-	UpdatedAt        *time.Time `mapstructure:"updated_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	ID               string     `mapstructure:"id" json:"id"`
+	Name             string     `mapstructure:"name" json:"name"`
+	Comment          string     `mapstructure:"comment" json:"comment"`
+	Shield           string     `mapstructure:"shield" json:"shield"`
+	RequestCondition string     `mapstructure:"request_condition" json:"request_condition"`
+	MaxConnDefault   uint       `mapstructure:"max_conn_default" json:"max_conn_default"`
+	ConnectTimeout   uint       `mapstructure:"connect_timeout" json:"connect_timeout"`
+	FirstByteTimeout uint       `mapstructure:"first_byte_timeout" json:"first_byte_timeout"`
+	Quorum           uint       `mapstructure:"quorum" json:"quorum"`
+	UseTLS           bool       `mapstructure:"use_tls" json:"use_tls"`
+	TLSCACert        string     `mapstructure:"tls_ca_cert" json:"tls_ca_cert"`
+	TLSCiphers       string     `mapstructure:"tls_ciphers" json:"tls_ciphers"`
+	TLSClientKey     string     `mapstructure:"tls_client_key" json:"tls_client_key"`
+	TLSClientCert    string     `mapstructure:"tls_client_cert" json:"tls_client_cert"`
+	TLSSNIHostname   string     `mapstructure:"tls_sni_hostname" json:"tls_sni_hostname"`
+	TLSCheckCert     bool       `mapstructure:"tls_check_cert" json:"tls_check_cert"`
+	TLSCertHostname  string     `mapstructure:"tls_cert_hostname" json:"tls_cert_hostname"`
+	MinTLSVersion    TLSVersion `mapstructure:"min_tls_version" json:"min_tls_version"`
+	MaxTLSVersion    TLSVersion `mapstructure:"max_tls_version" json:"max_tls_version"`
+	Healthcheck      string     `mapstructure:"healthcheck" json:"healthcheck"`
+	Type             PoolType   `mapstructure:"type" json:"type"`
+	OverrideHost     string     `mapstructure:"override_host" json:"override_host"`
+	CreatedAt        *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	DeletedAt        *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
+	UpdatedAt        *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
 }
 
 // poolsByName is a sortable list of pools.
@@ -129,8 +140,8 @@ type CreatePoolInput struct {
 	TLSSNIHostname   string      `url:"tls_sni_hostname,omitempty"`
 	TLSCheckCert     Compatibool `url:"tls_check_cert,omitempty"`
 	TLSCertHostname  string      `url:"tls_cert_hostname,omitempty"`
-	MinTLSVersion    string      `url:"min_tls_version,omitempty"`
-	MaxTLSVersion    string      `url:"max_tls_version,omitempty"`
+	MinTLSVersion    TLSVersion  `url:"min_tls_version,omitempty"`
+	MaxTLSVersion    TLSVersion  `url:"max_tls_version,omitempty"`
 	Healthcheck      string      `url:"healthcheck,omitempty"`
 	Type             PoolType    `url:"type,omitempty"`
 	OverrideHost     string      `url:"override_host,omitempty"`
@@ -150,6 +161,14 @@ func (c *Client) CreatePool(i *CreatePoolInput) (*Pool, error) {
 		return nil, ErrMissingName
 	}
 
+	if !i.Type.IsValid() {
+		return nil, ErrInvalidPoolType
+	}
+
+	if err := validateTLSVersions(i.MinTLSVersion, i.MaxTLSVersion); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/pool", i.ServiceID, i.ServiceVersion)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
@@ -230,8 +249,8 @@ type UpdatePoolInput struct {
 	TLSSNIHostname   *string      `url:"tls_sni_hostname,omitempty"`
 	TLSCheckCert     *Compatibool `url:"tls_check_cert,omitempty"`
 	TLSCertHostname  *string      `url:"tls_cert_hostname,omitempty"`
-	MinTLSVersion    *string      `url:"min_tls_version,omitempty"`
-	MaxTLSVersion    *string      `url:"max_tls_version,omitempty"`
+	MinTLSVersion    *TLSVersion  `url:"min_tls_version,omitempty"`
+	MaxTLSVersion    *TLSVersion  `url:"max_tls_version,omitempty"`
 	Healthcheck      *string      `url:"healthcheck,omitempty"`
 	Type             *PoolType    `url:"type,omitempty"`
 	OverrideHost     *string      `url:"override_host,omitempty"`
@@ -251,6 +270,24 @@ func (c *Client) UpdatePool(i *UpdatePoolInput) (*Pool, error) {
 		return nil, ErrMissingName
 	}
 
+	if i.Type != nil && !i.Type.IsValid() {
+		return nil, ErrInvalidPoolType
+	}
+
+	if i.MinTLSVersion != nil && !i.MinTLSVersion.IsValid() {
+		return nil, ErrInvalidMinTLSVersion
+	}
+
+	if i.MaxTLSVersion != nil && !i.MaxTLSVersion.IsValid() {
+		return nil, ErrInvalidMaxTLSVersion
+	}
+
+	if i.MinTLSVersion != nil && i.MaxTLSVersion != nil {
+		if err := validateTLSVersions(*i.MinTLSVersion, *i.MaxTLSVersion); err != nil {
+			return nil, err
+		}
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/pool/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
@@ -183,6 +183,26 @@ func TestClient_CreatePool_validation(t *testing.T) {
 	if err != ErrMissingName {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreatePool(&CreatePoolInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Type:           "round_robin",
+	})
+	if err != ErrInvalidPoolType {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreatePool(&CreatePoolInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		MinTLSVersion:  "TLSv1.2",
+	})
+	if err != ErrInvalidMinTLSVersion {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetPool_validation(t *testing.T) {
@@ -237,6 +257,16 @@ func TestClient_UpdatePool_validation(t *testing.T) {
 	if err != ErrMissingName {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.UpdatePool(&UpdatePoolInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Type:           PPoolType("round_robin"),
+	})
+	if err != ErrInvalidPoolType {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_DeletePool_validation(t *testing.T) {
@@ -1,6 +1,7 @@
 package fastly
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"sort"
@@ -9,21 +10,22 @@ import (
 
 // Pubsub represents an Pubsub logging response from the Fastly API.
 type Pubsub struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name              string     `mapstructure:"name"`
-	Topic             string     `mapstructure:"topic"`
-	User              string     `mapstructure:"user"`
-	SecretKey         string     `mapstructure:"secret_key"`
-	ProjectID         string     `mapstructure:"project_id"`
-	Format            string     `mapstructure:"format"`
-	FormatVersion     uint       `mapstructure:"format_version"`
-	ResponseCondition string     `mapstructure:"response_condition"`
-	Placement         string     `mapstructure:"placement"`
-	CreatedAt         *time.Time `mapstructure:"created_at"`
-	UpdatedAt         *time.Time `mapstructure:"updated_at"`
-	DeletedAt         *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name              string     `mapstructure:"name" json:"name"`
+	Topic             string     `mapstructure:"topic" json:"topic"`
+	User              string     `mapstructure:"user" json:"user"`
+	SecretKey         string     `mapstructure:"secret_key" json:"secret_key"`
+	AccountName       string     `mapstructure:"account_name" json:"account_name"`
+	ProjectID         string     `mapstructure:"project_id" json:"project_id"`
+	Format            string     `mapstructure:"format" json:"format"`
+	FormatVersion     uint       `mapstructure:"format_version" json:"format_version"`
+	ResponseCondition string     `mapstructure:"response_condition" json:"response_condition"`
+	Placement         string     `mapstructure:"placement" json:"placement"`
+	CreatedAt         *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // pubsubsByName is a sortable list of pubsubs.
@@ -81,6 +83,7 @@ type CreatePubsubInput struct {
 	Topic             string `url:"topic,omitempty"`
 	User              string `url:"user,omitempty"`
 	SecretKey         string `url:"secret_key,omitempty"`
+	AccountName       string `url:"account_name,omitempty"`
 	ProjectID         string `url:"project_id,omitempty"`
 	FormatVersion     uint   `url:"format_version,omitempty"`
 	Format            string `url:"format,omitempty"`
@@ -90,12 +93,16 @@ type CreatePubsubInput struct {
 
 // CreatePubsub creates a new Fastly Pubsub.
 func (c *Client) CreatePubsub(i *CreatePubsubInput) (*Pubsub, error) {
-	if i.ServiceID == "" {
-		return nil, ErrMissingServiceID
+	if err := validateServiceVersionName(i.ServiceID, i.ServiceVersion, i.Name); err != nil {
+		return nil, err
 	}
 
-	if i.ServiceVersion == 0 {
-		return nil, ErrMissingServiceVersion
+	if !validFormatVersion(int(i.FormatVersion)) {
+		return nil, ErrInvalidFormatVersion
+	}
+
+	if i.Format != "" && !json.Valid([]byte(i.Format)) {
+		return nil, ErrInvalidPubsubFormat
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/logging/pubsub", i.ServiceID, i.ServiceVersion)
@@ -165,6 +172,7 @@ type UpdatePubsubInput struct {
 	Topic             *string `url:"topic,omitempty"`
 	User              *string `url:"user,omitempty"`
 	SecretKey         *string `url:"secret_key,omitempty"`
+	AccountName       *string `url:"account_name,omitempty"`
 	ProjectID         *string `url:"project_id,omitempty"`
 	FormatVersion     *uint   `url:"format_version,omitempty"`
 	Format            *string `url:"format,omitempty"`
@@ -186,6 +194,14 @@ func (c *Client) UpdatePubsub(i *UpdatePubsubInput) (*Pubsub, error) {
 		return nil, ErrMissingName
 	}
 
+	if i.FormatVersion != nil && !validFormatVersion(int(*i.FormatVersion)) {
+		return nil, ErrInvalidFormatVersion
+	}
+
+	if i.Format != nil && *i.Format != "" && !json.Valid([]byte(*i.Format)) {
+		return nil, ErrInvalidPubsubFormat
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/logging/pubsub/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
@@ -2,25 +2,44 @@ package fastly
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
 )
 
+const (
+	// PurgeKeysMaximumKeys is the maximum number of surrogate keys sent in a
+	// single batch purge request.
+	PurgeKeysMaximumKeys = 256
+
+	// PurgeKeysMaximumHeaderLength is the maximum length, in bytes, of the
+	// Surrogate-Key header sent in a single batch purge request.
+	PurgeKeysMaximumHeaderLength = 16384
+)
+
 // Purge is a response from a purge request.
 type Purge struct {
 	// Status is the status of the purge, usually "ok".
-	Status string `mapstructure:"status"`
+	Status string `mapstructure:"status" json:"status"`
 
 	// ID is the unique ID of the purge request.
-	ID string `mapstructure:"id"`
+	ID string `mapstructure:"id" json:"id"`
+
+	// Debug is the debugging information from the response headers, set when
+	// the purge was requested with Debug.
+	Debug *DebugInfo `mapstructure:"-" json:"-"`
 }
 
 // PurgeInput is used as input to the Purge function.
 type PurgeInput struct {
-	// URL is the URL to purge (required).
+	// URL is the URL to purge (required). The scheme is optional, so both
+	// "https://example.com/path" and "example.com/path" are accepted.
 	URL string
 
 	// Soft performs a soft purge.
 	Soft bool
+
+	// Debug sends the Fastly-Debug header and fills in Purge.Debug.
+	Debug bool
 }
 
 // Purge instantly purges an individual URL.
@@ -29,16 +48,24 @@ func (c *Client) Purge(i *PurgeInput) (*Purge, error) {
 		return nil, ErrMissingURL
 	}
 
+	// The API expects the cached host and path, without a scheme.
+	target := i.URL
+	if idx := strings.Index(target, "://"); idx != -1 {
+		target = target[idx+3:]
+	}
+
 	ro := &RequestOptions{
 		Parallel: true,
+		Headers:  map[string]string{},
 	}
 	if i.Soft {
-		ro.Headers = map[string]string{
-			"Fastly-Soft-Purge": "1",
-		}
+		ro.Headers["Fastly-Soft-Purge"] = "1"
+	}
+	if i.Debug {
+		ro.Headers[DebugHeader] = "1"
 	}
 
-	resp, err := c.Post("purge/"+i.URL, ro)
+	resp, err := c.Post("purge/"+target, ro)
 	if err != nil {
 		return nil, err
 	}
@@ -47,6 +74,9 @@ func (c *Client) Purge(i *PurgeInput) (*Purge, error) {
 	if err := decodeBodyMap(resp.Body, &r); err != nil {
 		return nil, err
 	}
+	if i.Debug && r != nil {
+		r.Debug = parseDebugInfo(resp.Header)
+	}
 	return r, nil
 }
 
@@ -60,6 +90,9 @@ type PurgeKeyInput struct {
 
 	// Soft performs a soft purge.
 	Soft bool
+
+	// Debug sends the Fastly-Debug header and fills in Purge.Debug.
+	Debug bool
 }
 
 // PurgeKey instantly purges a particular service of items tagged with a key.
@@ -72,20 +105,20 @@ func (c *Client) PurgeKey(i *PurgeKeyInput) (*Purge, error) {
 		return nil, ErrMissingKey
 	}
 
-	path := fmt.Sprintf("/service/%s/purge/%s", i.ServiceID, i.Key)
+	path := fmt.Sprintf("/service/%s/purge/%s", i.ServiceID, url.PathEscape(i.Key))
 
-	ro := new(RequestOptions)
-	ro.Parallel = true
-	req, err := c.RawRequest("POST", path, ro)
-	if err != nil {
-		return nil, err
+	ro := &RequestOptions{
+		Parallel: true,
+		Headers:  map[string]string{},
 	}
-
 	if i.Soft {
-		req.Header.Set("Fastly-Soft-Purge", "1")
+		ro.Headers["Fastly-Soft-Purge"] = "1"
+	}
+	if i.Debug {
+		ro.Headers[DebugHeader] = "1"
 	}
 
-	resp, err := checkResp(c.HTTPClient.Do(req))
+	resp, err := c.Post(path, ro)
 	if err != nil {
 		return nil, err
 	}
@@ -94,6 +127,9 @@ func (c *Client) PurgeKey(i *PurgeKeyInput) (*Purge, error) {
 	if err := decodeBodyMap(resp.Body, &r); err != nil {
 		return nil, err
 	}
+	if i.Debug && r != nil {
+		r.Debug = parseDebugInfo(resp.Header)
+	}
 	return r, nil
 }
 
@@ -109,7 +145,13 @@ type PurgeKeysInput struct {
 	Soft bool
 }
 
-// PurgeKeys instantly purges a particular service of items tagged with a key.
+// PurgeKeys instantly purges a particular service of items tagged with any of
+// the given keys. It returns a map of each key to its purge ID.
+//
+// Keys are sent in the Surrogate-Key header. When there are more keys than fit
+// in a single request (see PurgeKeysMaximumKeys and
+// PurgeKeysMaximumHeaderLength), they are split across several requests and
+// the results merged.
 func (c *Client) PurgeKeys(i *PurgeKeysInput) (map[string]string, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
@@ -121,50 +163,73 @@ func (c *Client) PurgeKeys(i *PurgeKeysInput) (map[string]string, error) {
 
 	path := fmt.Sprintf("/service/%s/purge", i.ServiceID)
 
-	ro := new(RequestOptions)
-	ro.Parallel = true
-	req, err := c.RawRequest("POST", path, ro)
-	if err != nil {
-		return nil, err
-	}
-
-	if i.Soft {
-		req.Header.Set("Fastly-Soft-Purge", "1")
-	}
+	purges := make(map[string]string, len(i.Keys))
+	for _, keys := range batchPurgeKeys(i.Keys) {
+		ro := &RequestOptions{
+			Parallel: true,
+			Headers: map[string]string{
+				"Surrogate-Key": strings.Join(keys, " "),
+			},
+		}
+		if i.Soft {
+			ro.Headers["Fastly-Soft-Purge"] = "1"
+		}
 
-	req.Header.Set("Surrogate-Key", strings.Join(i.Keys, " "))
+		resp, err := c.Post(path, ro)
+		if err != nil {
+			return nil, err
+		}
 
-	resp, err := checkResp(c.HTTPClient.Do(req))
-	if err != nil {
-		return nil, err
+		var r map[string]string
+		if err := decodeBodyMap(resp.Body, &r); err != nil {
+			return nil, err
+		}
+		for k, v := range r {
+			purges[k] = v
+		}
 	}
+	return purges, nil
+}
 
-	var r map[string]string
-	if err := decodeBodyMap(resp.Body, &r); err != nil {
-		return nil, err
+// batchPurgeKeys splits keys into groups that each fit in a single
+// Surrogate-Key header.
+func batchPurgeKeys(keys []string) [][]string {
+	var batches [][]string
+	var batch []string
+	length := 0
+	for _, k := range keys {
+		n := len(k)
+		if len(batch) > 0 {
+			n++ // separating space
+		}
+		if len(batch) == PurgeKeysMaximumKeys || (len(batch) > 0 && length+n > PurgeKeysMaximumHeaderLength) {
+			batches = append(batches, batch)
+			batch, length, n = nil, 0, len(k)
+		}
+		batch = append(batch, k)
+		length += n
 	}
-	return r, nil
+	return append(batches, batch)
 }
 
-// PurgeAllInput is used as input to the Purge function.
+// PurgeAllInput is used as input to the PurgeAll function.
 type PurgeAllInput struct {
 	// ServiceID is the ID of the service (required).
 	ServiceID string
 }
 
 // PurgeAll instantly purges everything from a service.
+//
+// The purge is rejected on services whose purge_all is restricted (for example
+// when only surrogate key purging is allowed). The returned error is then an
+// *HTTPError whose Errors carry the message from the API.
 func (c *Client) PurgeAll(i *PurgeAllInput) (*Purge, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
 	}
 
 	path := fmt.Sprintf("/service/%s/purge_all", i.ServiceID)
-	req, err := c.RawRequest("POST", path, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := checkResp(c.HTTPClient.Do(req))
+	resp, err := c.Post(path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -174,5 +239,4 @@ func (c *Client) PurgeAll(i *PurgeAllInput) (*Purge, error) {
 		return nil, err
 	}
 	return r, nil
-
 }
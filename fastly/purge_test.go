@@ -1,6 +1,9 @@
 package fastly
 
 import (
+	"fmt"
+	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -85,3 +88,145 @@ func TestClient_PurgeAll(t *testing.T) {
 		t.Error("bad status")
 	}
 }
+
+func TestClient_Purge_request(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("bad method: %s", r.Method)
+		}
+		if r.URL.Path != "/purge/example.com/foo/bar" {
+			t.Errorf("bad path: %s", r.URL.Path)
+		}
+		if r.Header.Get("Fastly-Soft-Purge") != "1" {
+			t.Error("missing soft purge header")
+		}
+		w.Write([]byte(`{"status": "ok", "id": "123-456"}`))
+	})
+
+	purge, err := c.Purge(&PurgeInput{
+		URL:  "https://example.com/foo/bar",
+		Soft: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if purge.Status != "ok" {
+		t.Errorf("bad status: %q", purge.Status)
+	}
+	if purge.ID != "123-456" {
+		t.Errorf("bad id: %q", purge.ID)
+	}
+}
+
+func TestClient_PurgeKey_debug(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(DebugHeader) != "1" {
+			t.Error("missing debug header")
+		}
+		w.Header().Set("Fastly-Debug-Path", "(D cache-ams1 1557427742)")
+		w.Write([]byte(`{"status": "ok", "id": "123-456"}`))
+	})
+
+	p, err := c.PurgeKey(&PurgeKeyInput{
+		ServiceID: "foo",
+		Key:       "posts",
+		Debug:     true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Debug == nil || p.Debug.Path != "(D cache-ams1 1557427742)" {
+		t.Errorf("bad debug: %+v", p.Debug)
+	}
+}
+
+func TestClient_PurgeKeys_batches(t *testing.T) {
+	t.Parallel()
+
+	var requests int
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		keys := strings.Fields(r.Header.Get("Surrogate-Key"))
+		if len(keys) > PurgeKeysMaximumKeys {
+			t.Errorf("too many keys in one request: %d", len(keys))
+		}
+		var parts []string
+		for _, k := range keys {
+			parts = append(parts, fmt.Sprintf("%q: %q", k, "id-"+k))
+		}
+		fmt.Fprintf(w, "{%s}", strings.Join(parts, ","))
+	})
+
+	var keys []string
+	for i := 0; i < PurgeKeysMaximumKeys+10; i++ {
+		keys = append(keys, fmt.Sprintf("key-%d", i))
+	}
+
+	purges, err := c.PurgeKeys(&PurgeKeysInput{
+		ServiceID: "foo",
+		Keys:      keys,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requests != 2 {
+		t.Errorf("bad number of requests: %d", requests)
+	}
+	if len(purges) != len(keys) {
+		t.Errorf("bad length: %d", len(purges))
+	}
+	if purges["key-0"] != "id-key-0" {
+		t.Errorf("bad purge id: %q", purges["key-0"])
+	}
+}
+
+func TestBatchPurgeKeys_headerLength(t *testing.T) {
+	t.Parallel()
+
+	long := strings.Repeat("k", PurgeKeysMaximumHeaderLength/2)
+	batches := batchPurgeKeys([]string{long, long, "short"})
+	if len(batches) != 2 {
+		t.Fatalf("bad number of batches: %d", len(batches))
+	}
+	for _, b := range batches {
+		if n := len(strings.Join(b, " ")); n > PurgeKeysMaximumHeaderLength {
+			t.Errorf("batch header too long: %d", n)
+		}
+	}
+}
+
+func TestClient_PurgeAll_error(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"msg": "purge_all is not allowed", "detail": "only surrogate key purging is permitted"}`))
+	})
+
+	_, err := c.PurgeAll(&PurgeAllInput{
+		ServiceID: "foo",
+	})
+	herr, ok := err.(*HTTPError)
+	if !ok {
+		t.Fatalf("expected *HTTPError, got %T: %v", err, err)
+	}
+	if herr.StatusCode != http.StatusForbidden {
+		t.Errorf("bad status code: %d", herr.StatusCode)
+	}
+	if len(herr.Errors) != 1 || herr.Errors[0].Title != "purge_all is not allowed" {
+		t.Errorf("bad errors: %v", herr)
+	}
+}
+
+func TestClient_PurgeAll_validation(t *testing.T) {
+	_, err := testClient.PurgeAll(&PurgeAllInput{
+		ServiceID: "",
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -1,24 +1,26 @@
 package fastly
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 )
 
 // RealtimeStatsResponse is a response from Fastly's real-time analytics endpoint
 type RealtimeStatsResponse struct {
-	Timestamp      uint64          `mapstructure:"Timestamp"`
-	Data           []*RealtimeData `mapstructure:"Data"`
-	Error          string          `mapstructure:"Error"`
-	AggregateDelay uint32          `mapstructure:"AggregateDelay"`
+	Timestamp      uint64          `mapstructure:"Timestamp" json:"Timestamp"`
+	Data           []*RealtimeData `mapstructure:"Data" json:"Data"`
+	Error          string          `mapstructure:"Error" json:"Error"`
+	AggregateDelay uint32          `mapstructure:"AggregateDelay" json:"AggregateDelay"`
 }
 
 // RealtimeData represents combined stats for all Fastly's POPs and aggregate of them.
 // It also includes a timestamp of when the stats were recorded
 type RealtimeData struct {
-	Datacenter map[string]*Stats `mapstructure:"datacenter"`
-	Aggregated *Stats            `mapstructure:"aggregated"`
-	Recorded   uint64            `mapstructure:"recorded"`
+	Datacenter map[string]*Stats `mapstructure:"datacenter" json:"datacenter"`
+	Aggregated *Stats            `mapstructure:"aggregated" json:"aggregated"`
+	Recorded   uint64            `mapstructure:"recorded" json:"recorded"`
 }
 
 // GetRealtimeStatsInput is an input parameter to GetRealtimeStats function
@@ -33,8 +35,12 @@ type GetRealtimeStatsInput struct {
 // a timestamp which should be passed to the next call and so on.
 // More details at https://developer.fastly.com/reference/api/metrics-stats/realtime/
 func (c *RTSClient) GetRealtimeStats(i *GetRealtimeStatsInput) (*RealtimeStatsResponse, error) {
+	return c.getRealtimeStats(context.Background(), i)
+}
+
+func (c *RTSClient) getRealtimeStats(ctx context.Context, i *GetRealtimeStatsInput) (*RealtimeStatsResponse, error) {
 	var resp interface{}
-	if err := c.GetRealtimeStatsJSON(i, &resp); err != nil {
+	if err := c.getRealtimeStatsJSON(ctx, i, &resp); err != nil {
 		return nil, err
 	}
 
@@ -47,6 +53,10 @@ func (c *RTSClient) GetRealtimeStats(i *GetRealtimeStatsInput) (*RealtimeStatsRe
 
 // GetRealtimeStatsJSON fetches stats and decodes the response directly to the JSON struct dst.
 func (c *RTSClient) GetRealtimeStatsJSON(i *GetRealtimeStatsInput, dst interface{}) error {
+	return c.getRealtimeStatsJSON(context.Background(), i, dst)
+}
+
+func (c *RTSClient) getRealtimeStatsJSON(ctx context.Context, i *GetRealtimeStatsInput, dst interface{}) error {
 	if i.ServiceID == "" {
 		return ErrMissingServiceID
 	}
@@ -57,7 +67,7 @@ func (c *RTSClient) GetRealtimeStatsJSON(i *GetRealtimeStatsInput, dst interface
 		path = fmt.Sprintf("%s/limit/%d", path, i.Limit)
 	}
 
-	resp, err := c.client.Get(path, nil)
+	resp, err := c.client.Get(path, &RequestOptions{Context: ctx})
 	if err != nil {
 		return err
 	}
@@ -65,3 +75,40 @@ func (c *RTSClient) GetRealtimeStatsJSON(i *GetRealtimeStatsInput, dst interface
 
 	return json.NewDecoder(resp.Body).Decode(dst)
 }
+
+// StreamRealtimeStats long-polls the realtime stats of a service, calling f for
+// every data point received. Each response's Timestamp is passed to the next
+// request, starting from i.Timestamp.
+//
+// It runs until ctx is cancelled, a request fails or f returns an error, and
+// returns that error.
+func (c *RTSClient) StreamRealtimeStats(ctx context.Context, i *GetRealtimeStatsInput, f func(*RealtimeData) error) error {
+	if i.ServiceID == "" {
+		return ErrMissingServiceID
+	}
+
+	in := *i
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		resp, err := c.getRealtimeStats(ctx, &in)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		if resp.Error != "" {
+			return errors.New(resp.Error)
+		}
+
+		for _, d := range resp.Data {
+			if err := f(d); err != nil {
+				return err
+			}
+		}
+		in.Timestamp = resp.Timestamp
+	}
+}
@@ -1,6 +1,10 @@
 package fastly
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -55,3 +59,45 @@ func TestStatsClient_GetRealtimeStatsJSON(t *testing.T) {
 		t.Fatalf("got RenameTimestamp=%d, want nonzero", ret.RenameTimestamp)
 	}
 }
+
+func TestStatsClient_StreamRealtimeStats(t *testing.T) {
+	t.Parallel()
+
+	var paths []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		n := len(paths)
+		fmt.Fprintf(w, `{"Timestamp": %d, "Data": [{"recorded": %d}]}`, 100+n, n)
+	}))
+	t.Cleanup(ts.Close)
+
+	c, err := NewRealtimeStatsClientForEndpoint("", ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var recorded []uint64
+	err = c.StreamRealtimeStats(ctx, &GetRealtimeStatsInput{ServiceID: "foo"}, func(d *RealtimeData) error {
+		recorded = append(recorded, d.Recorded)
+		if len(recorded) == 3 {
+			cancel()
+		}
+		return nil
+	})
+	if err != context.Canceled {
+		t.Errorf("bad error: %v", err)
+	}
+	if len(recorded) != 3 {
+		t.Fatalf("bad number of data points: %d", len(recorded))
+	}
+
+	want := []string{"/v1/channel/foo/ts/0", "/v1/channel/foo/ts/101", "/v1/channel/foo/ts/102"}
+	for i, p := range want {
+		if paths[i] != p {
+			t.Errorf("bad path %d: want %q, have %q", i, p, paths[i])
+		}
+	}
+}
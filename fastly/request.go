@@ -1,10 +1,12 @@
 package fastly
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
 // RequestOptions is the list of options to pass to the request.
@@ -22,8 +24,23 @@ type RequestOptions struct {
 
 	// Can this request run in parallel
 	Parallel bool
+
+	// Context, when set, is attached to the request so that it can be
+	// cancelled.
+	Context context.Context
+
+	// Timeout, when positive, replaces the HTTP client's Timeout for this
+	// request, so that slow operations such as stats queries can be given
+	// longer than the client default, or quick ones less. Like the client
+	// Timeout, it covers each attempt up to reading the response body. If
+	// Context also has a deadline, the earlier of the two applies.
+	Timeout time.Duration
 }
 
+// requestTimeoutKey is the context key under which RawRequest stores
+// RequestOptions.Timeout for Client.do.
+type requestTimeoutKey struct{}
+
 // RawRequest accepts a verb, URL, and RequestOptions struct and returns the
 // constructed http.Request and any errors that occurred
 func (c *Client) RawRequest(verb, p string, ro *RequestOptions) (*http.Request, error) {
@@ -35,8 +52,16 @@ func (c *Client) RawRequest(verb, p string, ro *RequestOptions) (*http.Request,
 	// Append the path to the URL.
 	u := strings.TrimRight(c.url.String(), "/") + "/" + strings.TrimLeft(p, "/")
 
+	ctx := ro.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if ro.Timeout > 0 {
+		ctx = context.WithValue(ctx, requestTimeoutKey{}, ro.Timeout)
+	}
+
 	// Create the request object.
-	request, err := http.NewRequest(verb, u, ro.Body)
+	request, err := http.NewRequestWithContext(ctx, verb, u, ro.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +78,7 @@ func (c *Client) RawRequest(verb, p string, ro *RequestOptions) (*http.Request,
 	}
 
 	// Set the User-Agent.
-	request.Header.Set("User-Agent", UserAgent)
+	request.Header.Set("User-Agent", c.userAgent())
 
 	// Add any custom headers.
 	for k, v := range ro.Headers {
@@ -89,11 +114,15 @@ func (c *Client) SimpleGet(target string) (*http.Response, error) {
 	if len(c.apiKey) > 0 {
 		request.Header.Set(APIKeyHeader, c.apiKey)
 	}
-	request.Header.Set("User-Agent", UserAgent)
+	request.Header.Set("User-Agent", c.userAgent())
+
+	if c.DryRun {
+		return c.recordDryRun(request)
+	}
 
-	resp, err := checkResp(c.HTTPClient.Do(request))
+	resp, err := checkResp(c.recordRateLimit(c.doWithHooks(request, c.do)))
 	if err != nil {
-		return resp, err
+		return resp, c.redaction().error(err)
 	}
 	return resp, nil
 }
@@ -18,6 +18,17 @@ const (
 // RequestSettingAction is a type of request setting action.
 type RequestSettingAction string
 
+// IsValid reports whether the action is one supported by the Fastly API. An
+// empty action is valid.
+func (a RequestSettingAction) IsValid() bool {
+	switch a {
+	case "", RequestSettingActionLookup, RequestSettingActionPass:
+		return true
+	default:
+		return false
+	}
+}
+
 const (
 	// RequestSettingXFFClear clears any X-Forwarded-For headers.
 	RequestSettingXFFClear RequestSettingXFF = "clear"
@@ -39,26 +50,37 @@ const (
 // RequestSettingXFF is a type of X-Forwarded-For value to set.
 type RequestSettingXFF string
 
+// IsValid reports whether the value is one supported by the Fastly API. An
+// empty value is valid.
+func (x RequestSettingXFF) IsValid() bool {
+	switch x {
+	case "", RequestSettingXFFClear, RequestSettingXFFLeave, RequestSettingXFFAppend, RequestSettingXFFAppendAll, RequestSettingXFFOverwrite:
+		return true
+	default:
+		return false
+	}
+}
+
 // RequestSetting represents a request setting response from the Fastly API.
 type RequestSetting struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name             string               `mapstructure:"name"`
-	ForceMiss        bool                 `mapstructure:"force_miss"`
-	ForceSSL         bool                 `mapstructure:"force_ssl"`
-	Action           RequestSettingAction `mapstructure:"action"`
-	BypassBusyWait   bool                 `mapstructure:"bypass_busy_wait"`
-	MaxStaleAge      uint                 `mapstructure:"max_stale_age"`
-	HashKeys         string               `mapstructure:"hash_keys"`
-	XForwardedFor    RequestSettingXFF    `mapstructure:"xff"`
-	TimerSupport     bool                 `mapstructure:"timer_support"`
-	GeoHeaders       bool                 `mapstructure:"geo_headers"`
-	DefaultHost      string               `mapstructure:"default_host"`
-	RequestCondition string               `mapstructure:"request_condition"`
-	CreatedAt        *time.Time           `mapstructure:"created_at"`
-	UpdatedAt        *time.Time           `mapstructure:"updated_at"`
-	DeletedAt        *time.Time           `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name             string               `mapstructure:"name" json:"name"`
+	ForceMiss        bool                 `mapstructure:"force_miss" json:"force_miss"`
+	ForceSSL         bool                 `mapstructure:"force_ssl" json:"force_ssl"`
+	Action           RequestSettingAction `mapstructure:"action" json:"action"`
+	BypassBusyWait   bool                 `mapstructure:"bypass_busy_wait" json:"bypass_busy_wait"`
+	MaxStaleAge      uint                 `mapstructure:"max_stale_age" json:"max_stale_age"`
+	HashKeys         string               `mapstructure:"hash_keys" json:"hash_keys"`
+	XForwardedFor    RequestSettingXFF    `mapstructure:"xff" json:"xff"`
+	TimerSupport     bool                 `mapstructure:"timer_support" json:"timer_support"`
+	GeoHeaders       bool                 `mapstructure:"geo_headers" json:"geo_headers"`
+	DefaultHost      string               `mapstructure:"default_host" json:"default_host"`
+	RequestCondition string               `mapstructure:"request_condition" json:"request_condition"`
+	CreatedAt        *time.Time           `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt        *time.Time           `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt        *time.Time           `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // requestSettingsByName is a sortable list of request settings.
@@ -139,6 +161,20 @@ func (c *Client) CreateRequestSetting(i *CreateRequestSettingInput) (*RequestSet
 		return nil, ErrMissingServiceVersion
 	}
 
+	if !i.Action.IsValid() {
+		return nil, ErrInvalidRequestSettingAction
+	}
+
+	if !i.XForwardedFor.IsValid() {
+		return nil, ErrInvalidRequestSettingXFF
+	}
+
+	if err := c.checkConditions(i.ServiceID, i.ServiceVersion,
+		conditionRef{"RequestCondition", i.RequestCondition, ConditionTypeRequest},
+	); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/request_settings", i.ServiceID, i.ServiceVersion)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
@@ -232,6 +268,20 @@ func (c *Client) UpdateRequestSetting(i *UpdateRequestSettingInput) (*RequestSet
 		return nil, ErrMissingName
 	}
 
+	if !i.Action.IsValid() {
+		return nil, ErrInvalidRequestSettingAction
+	}
+
+	if !i.XForwardedFor.IsValid() {
+		return nil, ErrInvalidRequestSettingXFF
+	}
+
+	if err := c.checkConditions(i.ServiceID, i.ServiceVersion,
+		optionalConditionRef("RequestCondition", i.RequestCondition, ConditionTypeRequest),
+	); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/request_settings/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
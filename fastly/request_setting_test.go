@@ -212,6 +212,24 @@ func TestClient_CreateRequestSetting_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateRequestSetting(&CreateRequestSettingInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Action:         RequestSettingAction("bogus"),
+	})
+	if err != ErrInvalidRequestSettingAction {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateRequestSetting(&CreateRequestSettingInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		XForwardedFor:  RequestSettingXFF("bogus"),
+	})
+	if err != ErrInvalidRequestSettingXFF {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetRequestSetting_validation(t *testing.T) {
@@ -266,6 +284,16 @@ func TestClient_UpdateRequestSetting_validation(t *testing.T) {
 	if err != ErrMissingName {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.UpdateRequestSetting(&UpdateRequestSettingInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		XForwardedFor:  RequestSettingXFF("bogus"),
+	})
+	if err != ErrInvalidRequestSettingXFF {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_DeleteRequestSetting_validation(t *testing.T) {
@@ -0,0 +1,126 @@
+package fastly
+
+import (
+	"bytes"
+	"container/list"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+// DefaultResponseCacheSize is the number of responses kept by the response
+// cache when EnableResponseCache is given a non-positive size.
+const DefaultResponseCacheSize = 256
+
+// EnableResponseCache turns on ETag caching of GET responses. The client
+// remembers the ETag and body of up to size responses, evicting the least
+// recently used, and sends If-None-Match when requesting them again. When the
+// API answers 304 Not Modified, the cached body is returned as a 200 response,
+// so callers see the same result without the body being transferred again.
+func (c *Client) EnableResponseCache(size int) {
+	if size <= 0 {
+		size = DefaultResponseCacheSize
+	}
+	rc := &responseCache{
+		size:    size,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+
+	c.configLock.Lock()
+	c.responseCache = rc
+	c.configLock.Unlock()
+}
+
+// responseCache is a bounded, concurrency-safe LRU cache of GET responses
+// keyed by URL.
+type responseCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+// cachedResponse is a response stored in a responseCache.
+type cachedResponse struct {
+	key    string
+	etag   string
+	header http.Header
+	body   []byte
+}
+
+func (rc *responseCache) get(key string) (*cachedResponse, bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	e, ok := rc.entries[key]
+	if !ok {
+		return nil, false
+	}
+	rc.order.MoveToFront(e)
+	return e.Value.(*cachedResponse), true
+}
+
+func (rc *responseCache) put(cr *cachedResponse) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if e, ok := rc.entries[cr.key]; ok {
+		e.Value = cr
+		rc.order.MoveToFront(e)
+		return
+	}
+
+	rc.entries[cr.key] = rc.order.PushFront(cr)
+	for rc.order.Len() > rc.size {
+		oldest := rc.order.Back()
+		rc.order.Remove(oldest)
+		delete(rc.entries, oldest.Value.(*cachedResponse).key)
+	}
+}
+
+// doWithCache sends req using do, revalidating and storing GET responses in
+// the response cache when it is enabled.
+func (c *Client) doWithCache(req *http.Request, do func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	c.configLock.RLock()
+	rc := c.responseCache
+	c.configLock.RUnlock()
+	if rc == nil || req.Method != http.MethodGet {
+		return do(req)
+	}
+
+	key := req.URL.String()
+	cached, ok := rc.get(key)
+	if ok {
+		req.Header.Set("If-None-Match", cached.etag)
+	}
+
+	resp, err := do(req)
+	if err != nil {
+		return resp, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && ok:
+		resp.Body.Close()
+		resp.StatusCode = http.StatusOK
+		resp.Status = "200 OK"
+		resp.Header = cached.header.Clone()
+		resp.Body = ioutil.NopCloser(bytes.NewReader(cached.body))
+		resp.ContentLength = int64(len(cached.body))
+	case resp.StatusCode == http.StatusOK && resp.Header.Get("ETag") != "":
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return resp, err
+		}
+		rc.put(&cachedResponse{
+			key:    key,
+			etag:   resp.Header.Get("ETag"),
+			header: resp.Header.Clone(),
+			body:   body,
+		})
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	return resp, nil
+}
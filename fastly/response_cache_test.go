@@ -0,0 +1,55 @@
+package fastly
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClient_EnableResponseCache(t *testing.T) {
+	t.Parallel()
+
+	var hits, notModified int
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Write([]byte(`{"id":"SERVICE_ID","name":"my-service"}`))
+	})
+	c.EnableResponseCache(0)
+
+	for n := 0; n < 3; n++ {
+		s, err := c.GetService(&GetServiceInput{ID: "SERVICE_ID"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.Name != "my-service" {
+			t.Errorf("bad name on request %d: %q", n, s.Name)
+		}
+	}
+	if hits != 3 || notModified != 2 {
+		t.Errorf("bad counts: hits=%d notModified=%d", hits, notModified)
+	}
+}
+
+func TestResponseCache_evicts(t *testing.T) {
+	c := &Client{}
+	c.EnableResponseCache(2)
+	rc := c.responseCache
+
+	for n := 0; n < 3; n++ {
+		rc.put(&cachedResponse{key: fmt.Sprintf("k%d", n), etag: "e"})
+	}
+	if _, ok := rc.get("k0"); ok {
+		t.Error("expected k0 to be evicted")
+	}
+	for _, k := range []string{"k1", "k2"} {
+		if _, ok := rc.get(k); !ok {
+			t.Errorf("expected %s to be cached", k)
+		}
+	}
+}
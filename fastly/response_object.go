@@ -9,19 +9,25 @@ import (
 
 // ResponseObject represents a response object response from the Fastly API.
 type ResponseObject struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name             string     `mapstructure:"name"`
-	Status           uint       `mapstructure:"status"`
-	Response         string     `mapstructure:"response"`
-	Content          string     `mapstructure:"content"`
-	ContentType      string     `mapstructure:"content_type"`
-	RequestCondition string     `mapstructure:"request_condition"`
-	CacheCondition   string     `mapstructure:"cache_condition"`
-	CreatedAt        *time.Time `mapstructure:"created_at"`
-	UpdatedAt        *time.Time `mapstructure:"updated_at"`
-	DeletedAt        *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name             string     `mapstructure:"name" json:"name"`
+	Status           uint       `mapstructure:"status" json:"status"`
+	Response         string     `mapstructure:"response" json:"response"`
+	Content          string     `mapstructure:"content" json:"content"`
+	ContentType      string     `mapstructure:"content_type" json:"content_type"`
+	RequestCondition string     `mapstructure:"request_condition" json:"request_condition"`
+	CacheCondition   string     `mapstructure:"cache_condition" json:"cache_condition"`
+	CreatedAt        *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt        *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt        *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
+}
+
+// validHTTPStatus reports whether code is within the range of HTTP status
+// codes.
+func validHTTPStatus(code uint) bool {
+	return code >= 100 && code <= 599
 }
 
 // responseObjectsByName is a sortable list of response objects.
@@ -97,6 +103,17 @@ func (c *Client) CreateResponseObject(i *CreateResponseObjectInput) (*ResponseOb
 		return nil, ErrMissingServiceVersion
 	}
 
+	if i.Status != nil && !validHTTPStatus(*i.Status) {
+		return nil, ErrInvalidStatus
+	}
+
+	if err := c.checkConditions(i.ServiceID, i.ServiceVersion,
+		conditionRef{"RequestCondition", i.RequestCondition, ConditionTypeRequest},
+		conditionRef{"CacheCondition", i.CacheCondition, ConditionTypeCache},
+	); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/response_object", i.ServiceID, i.ServiceVersion)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
@@ -185,6 +202,17 @@ func (c *Client) UpdateResponseObject(i *UpdateResponseObjectInput) (*ResponseOb
 		return nil, ErrMissingName
 	}
 
+	if i.Status != nil && !validHTTPStatus(*i.Status) {
+		return nil, ErrInvalidStatus
+	}
+
+	if err := c.checkConditions(i.ServiceID, i.ServiceVersion,
+		optionalConditionRef("RequestCondition", i.RequestCondition, ConditionTypeRequest),
+		optionalConditionRef("CacheCondition", i.CacheCondition, ConditionTypeCache),
+	); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/response_object/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
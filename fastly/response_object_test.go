@@ -1,6 +1,8 @@
 package fastly
 
 import (
+	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -170,6 +172,15 @@ func TestClient_CreateResponseObject_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateResponseObject(&CreateResponseObjectInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Status:         Uint(600),
+	})
+	if err != ErrInvalidStatus {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetResponseObject_validation(t *testing.T) {
@@ -224,6 +235,16 @@ func TestClient_UpdateResponseObject_validation(t *testing.T) {
 	if err != ErrMissingName {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.UpdateResponseObject(&UpdateResponseObjectInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Status:         Uint(99),
+	})
+	if err != ErrInvalidStatus {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_DeleteResponseObject_validation(t *testing.T) {
@@ -252,3 +273,29 @@ func TestClient_DeleteResponseObject_validation(t *testing.T) {
 		t.Errorf("bad error: %s", err)
 	}
 }
+
+func TestClient_CreateResponseObject_largeContent(t *testing.T) {
+	t.Parallel()
+
+	content := strings.Repeat("<p>maintenance & \"upgrades\"\n</p>", 20000)
+
+	var received string
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		received = r.PostFormValue("content")
+		w.Write([]byte(`{"name": "maintenance"}`))
+	})
+
+	_, err := c.CreateResponseObject(&CreateResponseObjectInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "maintenance",
+		Status:         Uint(503),
+		Content:        content,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if received != content {
+		t.Errorf("content was altered in transit: sent %d bytes, received %d", len(content), len(received))
+	}
+}
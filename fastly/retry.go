@@ -0,0 +1,138 @@
+package fastly
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryConfig controls how the client retries requests that failed with a
+// transient error. Only idempotent requests (GET, HEAD, PUT and DELETE) that
+// receive a 429, 502 or 503 response are retried.
+type RetryConfig struct {
+	// MaxRetries is the maximum number of retries after the initial attempt.
+	MaxRetries int
+
+	// BaseDelay is the delay before the first retry. Each subsequent retry
+	// doubles the previous delay.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the delay between two attempts, including any delay
+	// requested by the API through the Retry-After header. A zero value means
+	// no cap.
+	MaxDelay time.Duration
+}
+
+// delay returns the duration to wait before the given retry attempt
+// (zero-based), preferring the Retry-After header of resp when present.
+func (rc *RetryConfig) delay(attempt int, resp *http.Response) time.Duration {
+	d, ok := retryAfter(resp)
+	if !ok {
+		d = rc.BaseDelay
+		for n := 0; n < attempt; n++ {
+			d *= 2
+			if rc.MaxDelay > 0 && d >= rc.MaxDelay {
+				break
+			}
+		}
+	}
+
+	if rc.MaxDelay > 0 && d > rc.MaxDelay {
+		d = rc.MaxDelay
+	}
+	return d
+}
+
+// retryAfter parses the Retry-After header, which is either a number of
+// seconds or an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
+
+// isRetryableMethod reports whether requests with the given verb can safely be
+// sent more than once.
+func isRetryableMethod(verb string) bool {
+	switch verb {
+	case "GET", "HEAD", "PUT", "DELETE":
+		return true
+	default:
+		return false
+	}
+}
+
+// isRetryableStatus reports whether a response with the given status code
+// indicates a transient failure.
+func isRetryableStatus(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable:
+		return true
+	default:
+		return false
+	}
+}
+
+// doWithRetry sends req, retrying according to the client's RetryConfig. When
+// no RetryConfig is set the request is sent exactly once.
+func (c *Client) doWithRetry(req *http.Request) (*http.Response, error) {
+	resp, err := c.do(req)
+
+	rc := c.RetryConfig
+	if rc == nil || !isRetryableMethod(req.Method) {
+		return resp, err
+	}
+
+	ctx := req.Context()
+	for attempt := 0; attempt < rc.MaxRetries; attempt++ {
+		if err != nil || !isRetryableStatus(resp.StatusCode) {
+			break
+		}
+
+		// A body that cannot be rewound cannot be sent again.
+		if req.Body != nil && req.GetBody == nil {
+			break
+		}
+
+		d := rc.delay(attempt, resp)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < d {
+			break
+		}
+
+		timer := time.NewTimer(d)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return resp, err
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, berr := req.GetBody()
+			if berr != nil {
+				return resp, err
+			}
+			req.Body = body
+		}
+
+		resp.Body.Close()
+		resp, err = c.do(req)
+	}
+
+	return resp, err
+}
@@ -0,0 +1,74 @@
+package fastly
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_RetryConfig(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"status": "ok"}`))
+	})
+
+	// No RetryConfig means no retries.
+	if _, err := c.Get("/", nil); err == nil {
+		t.Fatal("expected error without retries")
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("expected 1 call, got %d", n)
+	}
+
+	atomic.StoreInt32(&calls, 0)
+	c.RetryConfig = &RetryConfig{
+		MaxRetries: 3,
+		BaseDelay:  time.Millisecond,
+		MaxDelay:   5 * time.Millisecond,
+	}
+	if _, err := c.Get("/", nil); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&calls); n != 3 {
+		t.Errorf("expected 3 calls, got %d", n)
+	}
+
+	// POST is not idempotent and must not be retried.
+	atomic.StoreInt32(&calls, 0)
+	if _, err := c.Post("/", nil); err == nil {
+		t.Fatal("expected error for POST")
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("expected 1 call, got %d", n)
+	}
+}
+
+func TestRetryConfig_delay(t *testing.T) {
+	t.Parallel()
+
+	rc := &RetryConfig{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	resp := &http.Response{Header: http.Header{}}
+
+	for attempt, want := range []time.Duration{1 * time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second} {
+		if got := rc.delay(attempt, resp); got != want {
+			t.Errorf("attempt %d: expected %s, got %s", attempt, want, got)
+		}
+	}
+
+	resp.Header.Set("Retry-After", "3")
+	if got := rc.delay(0, resp); got != 3*time.Second {
+		t.Errorf("expected Retry-After to be honored, got %s", got)
+	}
+
+	resp.Header.Set("Retry-After", "120")
+	if got := rc.delay(0, resp); got != rc.MaxDelay {
+		t.Errorf("expected Retry-After to be capped, got %s", got)
+	}
+}
@@ -39,33 +39,33 @@ const (
 
 // S3 represents a S3 response from the Fastly API.
 type S3 struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name                         string                 `mapstructure:"name"`
-	BucketName                   string                 `mapstructure:"bucket_name"`
-	Domain                       string                 `mapstructure:"domain"`
-	AccessKey                    string                 `mapstructure:"access_key"`
-	SecretKey                    string                 `mapstructure:"secret_key"`
-	IAMRole                      string                 `mapstructure:"iam_role"`
-	Path                         string                 `mapstructure:"path"`
-	Period                       uint                   `mapstructure:"period"`
-	CompressionCodec             string                 `mapstructure:"compression_codec"`
-	GzipLevel                    uint                   `mapstructure:"gzip_level"`
-	Format                       string                 `mapstructure:"format"`
-	FormatVersion                uint                   `mapstructure:"format_version"`
-	ResponseCondition            string                 `mapstructure:"response_condition"`
-	MessageType                  string                 `mapstructure:"message_type"`
-	TimestampFormat              string                 `mapstructure:"timestamp_format"`
-	Placement                    string                 `mapstructure:"placement"`
-	PublicKey                    string                 `mapstructure:"public_key"`
-	Redundancy                   S3Redundancy           `mapstructure:"redundancy"`
-	ServerSideEncryptionKMSKeyID string                 `mapstructure:"server_side_encryption_kms_key_id"`
-	ServerSideEncryption         S3ServerSideEncryption `mapstructure:"server_side_encryption"`
-	CreatedAt                    *time.Time             `mapstructure:"created_at"`
-	UpdatedAt                    *time.Time             `mapstructure:"updated_at"`
-	DeletedAt                    *time.Time             `mapstructure:"deleted_at"`
-	ACL                          S3AccessControlList    `mapstructure:"acl"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name                         string                 `mapstructure:"name" json:"name"`
+	BucketName                   string                 `mapstructure:"bucket_name" json:"bucket_name"`
+	Domain                       string                 `mapstructure:"domain" json:"domain"`
+	AccessKey                    string                 `mapstructure:"access_key" json:"access_key"`
+	SecretKey                    string                 `mapstructure:"secret_key" json:"secret_key"`
+	IAMRole                      string                 `mapstructure:"iam_role" json:"iam_role"`
+	Path                         string                 `mapstructure:"path" json:"path"`
+	Period                       uint                   `mapstructure:"period" json:"period"`
+	CompressionCodec             string                 `mapstructure:"compression_codec" json:"compression_codec"`
+	GzipLevel                    uint                   `mapstructure:"gzip_level" json:"gzip_level"`
+	Format                       string                 `mapstructure:"format" json:"format"`
+	FormatVersion                uint                   `mapstructure:"format_version" json:"format_version"`
+	ResponseCondition            string                 `mapstructure:"response_condition" json:"response_condition"`
+	MessageType                  string                 `mapstructure:"message_type" json:"message_type"`
+	TimestampFormat              string                 `mapstructure:"timestamp_format" json:"timestamp_format"`
+	Placement                    string                 `mapstructure:"placement" json:"placement"`
+	PublicKey                    string                 `mapstructure:"public_key" json:"public_key"`
+	Redundancy                   S3Redundancy           `mapstructure:"redundancy" json:"redundancy"`
+	ServerSideEncryptionKMSKeyID string                 `mapstructure:"server_side_encryption_kms_key_id" json:"server_side_encryption_kms_key_id"`
+	ServerSideEncryption         S3ServerSideEncryption `mapstructure:"server_side_encryption" json:"server_side_encryption"`
+	CreatedAt                    *time.Time             `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt                    *time.Time             `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt                    *time.Time             `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
+	ACL                          S3AccessControlList    `mapstructure:"acl" json:"acl"`
 }
 
 // s3sByName is a sortable list of S3s.
@@ -9,19 +9,19 @@ import (
 
 // Scalyr represents a scalyr response from the Fastly API.
 type Scalyr struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name              string     `mapstructure:"name"`
-	Format            string     `mapstructure:"format"`
-	FormatVersion     uint       `mapstructure:"format_version"`
-	Token             string     `mapstructure:"token"`
-	Region            string     `mapstructure:"region"`
-	ResponseCondition string     `mapstructure:"response_condition"`
-	Placement         string     `mapstructure:"placement"`
-	CreatedAt         *time.Time `mapstructure:"created_at"`
-	UpdatedAt         *time.Time `mapstructure:"updated_at"`
-	DeletedAt         *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name              string     `mapstructure:"name" json:"name"`
+	Format            string     `mapstructure:"format" json:"format"`
+	FormatVersion     uint       `mapstructure:"format_version" json:"format_version"`
+	Token             string     `mapstructure:"token" json:"token"`
+	Region            string     `mapstructure:"region" json:"region"`
+	ResponseCondition string     `mapstructure:"response_condition" json:"response_condition"`
+	Placement         string     `mapstructure:"placement" json:"placement"`
+	CreatedAt         *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // scalyrByName is a sortable list of scalyrs.
@@ -67,6 +67,17 @@ func (c *Client) ListScalyrs(i *ListScalyrsInput) ([]*Scalyr, error) {
 	return ss, nil
 }
 
+// validScalyrRegion reports whether r is a Scalyr region supported by
+// Fastly. An empty value leaves the API default in place.
+func validScalyrRegion(r string) bool {
+	switch r {
+	case "", "US", "EU":
+		return true
+	default:
+		return false
+	}
+}
+
 // CreateScalyrInput is used as input to the CreateScalyr function.
 type CreateScalyrInput struct {
 	// ServiceID is the ID of the service (required).
@@ -86,12 +97,12 @@ type CreateScalyrInput struct {
 
 // CreateScalyr creates a new Fastly scalyr.
 func (c *Client) CreateScalyr(i *CreateScalyrInput) (*Scalyr, error) {
-	if i.ServiceID == "" {
-		return nil, ErrMissingServiceID
+	if err := validateServiceVersionName(i.ServiceID, i.ServiceVersion, i.Name); err != nil {
+		return nil, err
 	}
 
-	if i.ServiceVersion == 0 {
-		return nil, ErrMissingServiceVersion
+	if !validScalyrRegion(i.Region) {
+		return nil, ErrInvalidRegion
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/logging/scalyr", i.ServiceID, i.ServiceVersion)
@@ -180,6 +191,10 @@ func (c *Client) UpdateScalyr(i *UpdateScalyrInput) (*Scalyr, error) {
 		return nil, ErrMissingName
 	}
 
+	if i.Region != nil && !validScalyrRegion(*i.Region) {
+		return nil, ErrInvalidRegion
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/logging/scalyr/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
@@ -185,6 +185,25 @@ func TestClient_CreateScalyr_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateScalyr(&CreateScalyrInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateScalyr(&CreateScalyrInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Region:         "AP",
+	})
+	if err != ErrInvalidRegion {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetScalyr_validation(t *testing.T) {
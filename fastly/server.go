@@ -8,20 +8,20 @@ import (
 
 // ServerType represents a server response from the Fastly API.
 type Server struct {
-	ServiceID string `mapstructure:"service_id"`
-	PoolID    string `mapstructure:"pool_id"`
-	ID        string `mapstructure:"id"`
-
-	Address      string     `mapstructure:"address"`
-	Comment      string     `mapstructure:"comment"`
-	Weight       uint       `mapstructure:"weight"`
-	MaxConn      uint       `mapstructure:"max_conn"`
-	Port         uint       `mapstructure:"port"`
-	Disabled     bool       `mapstructure:"disabled"`
-	OverrideHost string     `mapstructure:"override_host"`
-	CreatedAt    *time.Time `mapstructure:"created_at"`
-	DeletedAt    *time.Time `mapstructure:"deleted_at"`
-	UpdatedAt    *time.Time `mapstructure:"updated_at"`
+	ServiceID string `mapstructure:"service_id" json:"service_id"`
+	PoolID    string `mapstructure:"pool_id" json:"pool_id"`
+	ID        string `mapstructure:"id" json:"id"`
+
+	Address      string     `mapstructure:"address" json:"address"`
+	Comment      string     `mapstructure:"comment" json:"comment"`
+	Weight       uint       `mapstructure:"weight" json:"weight"`
+	MaxConn      uint       `mapstructure:"max_conn" json:"max_conn"`
+	Port         uint       `mapstructure:"port" json:"port"`
+	Disabled     bool       `mapstructure:"disabled" json:"disabled"`
+	OverrideHost string     `mapstructure:"override_host" json:"override_host"`
+	CreatedAt    *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	DeletedAt    *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
+	UpdatedAt    *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
 }
 
 // serversByAddress is a sortable list of servers.
@@ -1,48 +1,68 @@
 package fastly
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"net/url"
 	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
+
+	"github.com/peterhellberg/link"
+)
+
+const (
+	// ServiceTypeVCL is the type for VCL services.
+	ServiceTypeVCL = "vcl"
+	// ServiceTypeWasm is the type for Wasm (Compute@Edge) services.
+	ServiceTypeWasm = "wasm"
 )
 
 // Service represents a single service for the Fastly account.
 type Service struct {
-	ID            string     `mapstructure:"id"`
-	Name          string     `mapstructure:"name"`
-	Type          string     `mapstructure:"type"`
-	Comment       string     `mapstructure:"comment"`
-	CustomerID    string     `mapstructure:"customer_id"`
-	CreatedAt     *time.Time `mapstructure:"created_at"`
-	UpdatedAt     *time.Time `mapstructure:"updated_at"`
-	DeletedAt     *time.Time `mapstructure:"deleted_at"`
-	ActiveVersion uint       `mapstructure:"version"`
-	Versions      []*Version `mapstructure:"versions"`
+	ID            string     `mapstructure:"id" json:"id"`
+	Name          string     `mapstructure:"name" json:"name"`
+	Type          string     `mapstructure:"type" json:"type"`
+	Comment       string     `mapstructure:"comment" json:"comment"`
+	CustomerID    string     `mapstructure:"customer_id" json:"customer_id"`
+	CreatedAt     *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt     *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt     *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
+	ActiveVersion uint       `mapstructure:"version" json:"version"`
+	Versions      []*Version `mapstructure:"versions" json:"versions"`
+
+	// Existing is set when CreateService returned a service that already
+	// existed rather than creating it. See
+	// CreateServiceInput.ReturnExistingOnConflict.
+	Existing bool `mapstructure:"-" json:"-"`
 }
 
 type ServiceDetail struct {
-	ID            string     `mapstructure:"id"`
-	Name          string     `mapstructure:"name"`
-	Type          string     `mapstructure:"type"`
-	Comment       string     `mapstructure:"comment"`
-	CustomerID    string     `mapstructure:"customer_id"`
-	ActiveVersion Version    `mapstructure:"active_version"`
-	Version       Version    `mapstructure:"version"`
-	Versions      []*Version `mapstructure:"versions"`
-	CreatedAt     *time.Time `mapstructure:"created_at"`
-	UpdatedAt     *time.Time `mapstructure:"updated_at"`
-	DeletedAt     *time.Time `mapstructure:"deleted_at"`
+	ID            string     `mapstructure:"id" json:"id"`
+	Name          string     `mapstructure:"name" json:"name"`
+	Type          string     `mapstructure:"type" json:"type"`
+	Comment       string     `mapstructure:"comment" json:"comment"`
+	CustomerID    string     `mapstructure:"customer_id" json:"customer_id"`
+	ActiveVersion Version    `mapstructure:"active_version" json:"active_version"`
+	Version       Version    `mapstructure:"version" json:"version"`
+	Versions      []*Version `mapstructure:"versions" json:"versions"`
+	CreatedAt     *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt     *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt     *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 type ServiceDomain struct {
-	Locked         bool       `mapstructure:"locked"`
-	Name           string     `mapstructure:"name"`
-	DeletedAt      *time.Time `mapstructure:"deleted_at"`
-	ServiceID      string     `mapstructure:"service_id"`
-	ServiceVersion int64      `mapstructure:"version"`
-	CreatedAt      *time.Time `mapstructure:"created_at"`
-	Comment        string     `mapstructure:"comment"`
-	UpdatedAt      *time.Time `mapstructure:"updated_at"`
+	Locked         bool       `mapstructure:"locked" json:"locked"`
+	Name           string     `mapstructure:"name" json:"name"`
+	DeletedAt      *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
+	ServiceID      string     `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int64      `mapstructure:"version" json:"version"`
+	CreatedAt      *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	Comment        string     `mapstructure:"comment" json:"comment"`
+	UpdatedAt      *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
 }
 type ServiceDomainsList []*ServiceDomain
 
@@ -57,34 +77,228 @@ func (s servicesByName) Less(i, j int) bool {
 }
 
 // ListServicesInput is used as input to the ListServices function.
-type ListServicesInput struct{}
+type ListServicesInput struct {
+	// Direction is the sort direction, either "ascend" or "descend".
+	Direction string
+
+	// Page is the page to start from. Defaults to the first page.
+	Page int
+
+	// PerPage is the number of services to fetch per page. Defaults to 100.
+	PerPage int
+
+	// Sort is the field to sort the results by.
+	Sort string
+
+	// Type, when set, restricts ListServices to services of that type, either
+	// ServiceTypeVCL or ServiceTypeWasm.
+	Type string
+
+	// NameContains, when set, restricts ListServices to services whose name
+	// contains it, ignoring case.
+	NameContains string
+}
+
+// matches reports whether s passes the Type and NameContains filters of i.
+func (i *ListServicesInput) matches(s *Service) bool {
+	if i.Type != "" && s.Type != i.Type {
+		return false
+	}
+	return i.NameContains == "" || strings.Contains(strings.ToLower(s.Name), strings.ToLower(i.NameContains))
+}
 
 // ListServices returns the full list of services for the current account.
+// Every page of results is fetched, starting from the page given in the input.
+// The API cannot filter services, so the Type and NameContains filters are
+// applied to the fetched services.
 func (c *Client) ListServices(i *ListServicesInput) ([]*Service, error) {
-	resp, err := c.Get("/service", nil)
+	return c.listServices(context.Background(), i)
+}
+
+// listServices is ListServices with a context for the requests.
+func (c *Client) listServices(ctx context.Context, i *ListServicesInput) ([]*Service, error) {
+	if i == nil {
+		i = &ListServicesInput{}
+	}
+
+	switch i.Type {
+	case "", ServiceTypeVCL, ServiceTypeWasm:
+	default:
+		return nil, ErrInvalidServiceType
+	}
+
+	var s []*Service
+
+	p := c.NewListServicesPaginator(i)
+	p.ctx = ctx
+	for n := 0; p.HasNext(); n++ {
+		if n == maxListPages {
+			return nil, ErrTooManyPages
+		}
+		page, err := p.GetNext()
+		if err != nil {
+			return nil, err
+		}
+		for _, svc := range page {
+			if i.matches(svc) {
+				s = append(s, svc)
+			}
+		}
+	}
+
+	// Keep the API's order when the caller asked for a specific sort.
+	if i.Sort == "" {
+		sort.Stable(servicesByName(s))
+	}
+	return s, nil
+}
+
+// ListServicesPaginator fetches services one page at a time.
+type ListServicesPaginator struct {
+	consumed    bool
+	CurrentPage int
+	NextPage    int
+	LastPage    int
+	client      *Client
+	options     *ListServicesInput
+
+	// ctx, when set, is the context of the page requests.
+	ctx context.Context
+}
+
+// HasNext returns a boolean indicating whether more pages are available
+func (p *ListServicesPaginator) HasNext() bool {
+	return !p.consumed || p.NextPage != 0
+}
+
+// Remaining returns the remaining page count
+func (p *ListServicesPaginator) Remaining() int {
+	if p.LastPage == 0 {
+		return 0
+	}
+	return p.LastPage - p.CurrentPage
+}
+
+// GetNext retrieves data in the next page
+func (p *ListServicesPaginator) GetNext() ([]*Service, error) {
+	return p.client.listServicesWithPage(p.options, p)
+}
+
+// NewListServicesPaginator returns a new ListServicesPaginator
+func (c *Client) NewListServicesPaginator(i *ListServicesInput) *ListServicesPaginator {
+	return &ListServicesPaginator{
+		client:  c,
+		options: i,
+	}
+}
+
+// listServicesWithPage returns a list of services of a given page
+func (c *Client) listServicesWithPage(i *ListServicesInput, p *ListServicesPaginator) ([]*Service, error) {
+	var perPage int
+	const maxPerPage = 100
+	if i.PerPage <= 0 {
+		perPage = maxPerPage
+	} else {
+		perPage = i.PerPage
+	}
+
+	if p.CurrentPage == 0 {
+		p.CurrentPage = 1
+		if i.Page > 0 {
+			p.CurrentPage = i.Page
+		}
+	} else if p.NextPage != 0 {
+		p.CurrentPage = p.NextPage
+	} else {
+		p.CurrentPage = p.CurrentPage + 1
+	}
+
+	requestOptions := &RequestOptions{
+		Context: p.ctx,
+		Params: map[string]string{
+			"per_page": strconv.Itoa(perPage),
+			"page":     strconv.Itoa(p.CurrentPage),
+		},
+	}
+
+	if i.Direction != "" {
+		requestOptions.Params["direction"] = i.Direction
+	}
+	if i.Sort != "" {
+		requestOptions.Params["sort"] = i.Sort
+	}
+
+	resp, err := c.Get("/service", requestOptions)
 	if err != nil {
 		return nil, err
 	}
 
+	// The next page is only known from the Link header; not every response
+	// includes a last page, so the presence of "next" alone drives paging.
+	p.NextPage = 0
+	for _, l := range link.ParseResponse(resp) {
+		// indicates the Link response header contained the next page instruction
+		if l.Rel == "next" {
+			u, _ := url.Parse(l.URI)
+			query := u.Query()
+			p.NextPage, _ = strconv.Atoi(query.Get("page"))
+		}
+		// indicates the Link response header contained the last page instruction
+		if l.Rel == "last" {
+			u, _ := url.Parse(l.URI)
+			query := u.Query()
+			p.LastPage, _ = strconv.Atoi(query.Get("page"))
+		}
+	}
+
+	p.consumed = true
+
 	var s []*Service
 	if err := decodeBodyMap(resp.Body, &s); err != nil {
 		return nil, err
 	}
-	sort.Stable(servicesByName(s))
+
 	return s, nil
 }
 
 // CreateServiceInput is used as input to the CreateService function.
 type CreateServiceInput struct {
-	Name    string `url:"name,omitempty"`
+	// Name is the name of the service and is required.
+	Name string `url:"name,omitempty"`
+
+	// Type is the type of the service, either ServiceTypeVCL or
+	// ServiceTypeWasm. It defaults to ServiceTypeVCL when empty.
 	Type    string `url:"type,omitempty"`
 	Comment string `url:"comment,omitempty"`
+
+	// ReturnExistingOnConflict, when the API reports that a service with this
+	// name already exists, returns that service with Existing set instead of
+	// an error. This makes retrying a create that timed out safe.
+	ReturnExistingOnConflict bool `url:"-"`
 }
 
 // CreateService creates a new service with the given information.
 func (c *Client) CreateService(i *CreateServiceInput) (*Service, error) {
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	switch i.Type {
+	case "", ServiceTypeVCL, ServiceTypeWasm:
+	default:
+		return nil, ErrInvalidServiceType
+	}
+
 	resp, err := c.PostForm("/service", i, nil)
 	if err != nil {
+		if herr, ok := err.(*HTTPError); ok && i.ReturnExistingOnConflict && herr.isConflict() {
+			s, serr := c.SearchService(&SearchServiceInput{Name: i.Name})
+			if serr != nil {
+				return nil, err
+			}
+			s.Existing = true
+			return s, nil
+		}
 		return nil, err
 	}
 
@@ -95,14 +309,87 @@ func (c *Client) CreateService(i *CreateServiceInput) (*Service, error) {
 	return s, nil
 }
 
+// ServiceType returns the type (ServiceTypeVCL or ServiceTypeWasm) of the
+// service with the given ID. A service's type cannot change, so the result is
+// cached for the lifetime of the client.
+func (c *Client) ServiceType(serviceID string) (string, error) {
+	if serviceID == "" {
+		return "", ErrMissingServiceID
+	}
+
+	c.serviceTypesLock.Lock()
+	t, ok := c.serviceTypes[serviceID]
+	c.serviceTypesLock.Unlock()
+	if ok {
+		return t, nil
+	}
+
+	s, err := c.GetService(&GetServiceInput{ID: serviceID})
+	if err != nil {
+		return "", err
+	}
+	if c.DryRun {
+		return "", ErrDryRun
+	}
+	t = s.Type
+	if t == "" {
+		t = ServiceTypeVCL
+	}
+
+	c.serviceTypesLock.Lock()
+	if c.serviceTypes == nil {
+		c.serviceTypes = make(map[string]string)
+	}
+	c.serviceTypes[serviceID] = t
+	c.serviceTypesLock.Unlock()
+	return t, nil
+}
+
+// EnableServiceTypeChecks makes operations that only apply to one type of
+// service, such as CreateVCL and CreateSnippet (VCL services) or UpdatePackage
+// (wasm services), look up the service's type with ServiceType and return a
+// *ServiceTypeError instead of sending a request the API would reject. The
+// lookup costs one extra request per service.
+func (c *Client) EnableServiceTypeChecks() {
+	c.configLock.Lock()
+	c.serviceTypeChecks = true
+	c.configLock.Unlock()
+}
+
+// checkServiceType returns a *ServiceTypeError if service type checks are
+// enabled and the service is not of type required. Checks are skipped in
+// DryRun mode, where the service cannot be looked up.
+func (c *Client) checkServiceType(serviceID, required string) error {
+	c.configLock.RLock()
+	enabled := c.serviceTypeChecks
+	c.configLock.RUnlock()
+	if !enabled || c.DryRun {
+		return nil
+	}
+
+	t, err := c.ServiceType(serviceID)
+	if err != nil {
+		return err
+	}
+	if t != required {
+		return &ServiceTypeError{ServiceID: serviceID, Type: t, Required: required}
+	}
+	return nil
+}
+
 // GetServiceInput is used as input to the GetService function.
 type GetServiceInput struct {
 	ID string
+
+	// Version, when set, makes GetServiceDetails describe that version in
+	// ServiceDetail.Version instead of the active or latest one. It is
+	// ignored by GetService.
+	Version int
 }
 
 // GetService retrieves the service information for the service with the given
 // id. If no service exists for the given id, the API returns a 400 response
-// (not a 404).
+// (not a 404), which can be detected with HTTPError.IsBadRequest.
 func (c *Client) GetService(i *GetServiceInput) (*Service, error) {
 	if i.ID == "" {
 		return nil, ErrMissingID
@@ -134,15 +421,27 @@ func (c *Client) GetService(i *GetServiceInput) (*Service, error) {
 	return s, nil
 }
 
-// GetService retrieves the details for the service with the given id. If no
-// service exists for the given id, the API returns a 400 response (not a 404).
+// GetServiceDetails retrieves the details for the service with the given id.
+// If no service exists for the given id, the API returns a 400 response (not a
+// 404).
 func (c *Client) GetServiceDetails(i *GetServiceInput) (*ServiceDetail, error) {
 	if i.ID == "" {
 		return nil, ErrMissingID
 	}
 
-	path := fmt.Sprintf("/service/%s/details", i.ID)
-	resp, err := c.Get(path, nil)
+	return c.getServiceDetails(context.Background(), i.ID, i.Version)
+}
+
+// getServiceDetails fetches the details of a service, describing the given
+// version, or the active or latest version when version is zero.
+func (c *Client) getServiceDetails(ctx context.Context, id string, version int) (*ServiceDetail, error) {
+	ro := &RequestOptions{Context: ctx}
+	if version != 0 {
+		ro.Params = map[string]string{"version": strconv.Itoa(version)}
+	}
+
+	path := fmt.Sprintf("/service/%s/details", id)
+	resp, err := c.Get(path, ro)
 	if err != nil {
 		return nil, err
 	}
@@ -155,6 +454,100 @@ func (c *Client) GetServiceDetails(i *GetServiceInput) (*ServiceDetail, error) {
 	return s, nil
 }
 
+// GetAllServiceDetails fetches the details of every service in the account,
+// using up to concurrency requests in parallel. Results are ordered by service
+// name, as with ListServices. Services whose details could not be fetched are
+// left out and reported through a *MultiError, alongside the details that were
+// fetched.
+//
+// Before each request, if the most recently reported rate limit has fewer than
+// concurrency requests remaining, the fetch waits for the rate limit window to
+// reset.
+func (c *Client) GetAllServiceDetails(ctx context.Context, concurrency int) ([]*ServiceDetail, error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	services, err := c.listServices(ctx, &ListServicesInput{})
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, err
+	}
+
+	details := make([]*ServiceDetail, len(services))
+	errs := make([]error, len(services))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for n := range jobs {
+				if errs[n] = c.waitForRateLimit(ctx, concurrency); errs[n] != nil {
+					continue
+				}
+				details[n], errs[n] = c.getServiceDetails(ctx, services[n].ID, 0)
+			}
+		}()
+	}
+
+dispatch:
+	for n := range services {
+		select {
+		case jobs <- n:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var result []*ServiceDetail
+	merr := &MultiError{Errors: map[string]error{}}
+	for n, s := range services {
+		if errs[n] != nil {
+			merr.Errors[s.ID] = errs[n]
+			continue
+		}
+		result = append(result, details[n])
+	}
+
+	if len(merr.Errors) > 0 {
+		return result, merr
+	}
+	return result, nil
+}
+
+// waitForRateLimit blocks until the rate limit window resets when fewer than
+// threshold requests remain in it, or until ctx is done.
+func (c *Client) waitForRateLimit(ctx context.Context, threshold int) error {
+	reset := c.RateLimitReset()
+	if reset.IsZero() || c.RateLimitRemaining() >= threshold {
+		return ctx.Err()
+	}
+
+	d := time.Until(reset)
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 // UpdateServiceInput is used as input to the UpdateService function.
 type UpdateServiceInput struct {
 	ServiceID string
@@ -193,9 +586,17 @@ func (c *Client) UpdateService(i *UpdateServiceInput) (*Service, error) {
 // DeleteServiceInput is used as input to the DeleteService function.
 type DeleteServiceInput struct {
 	ID string
+
+	// IgnoreMissing treats a service that does not exist (or was already
+	// deleted) as successfully deleted.
+	IgnoreMissing bool
 }
 
-// DeleteService updates the service with the given input.
+// DeleteService deletes the service with the given ID. A service with an
+// active version cannot be deleted and must be deactivated first. When the
+// API rejects the deletion and the service turns out to have an active
+// version, a *ServiceActiveError is returned, which matches ErrServiceActive
+// with errors.Is and wraps the API's error.
 func (c *Client) DeleteService(i *DeleteServiceInput) error {
 	if i.ID == "" {
 		return ErrMissingID
@@ -204,6 +605,20 @@ func (c *Client) DeleteService(i *DeleteServiceInput) error {
 	path := fmt.Sprintf("/service/%s", i.ID)
 	resp, err := c.Delete(path, nil)
 	if err != nil {
+		herr, ok := err.(*HTTPError)
+		if !ok {
+			return err
+		}
+		if i.IgnoreMissing && herr.isRecordNotFound() {
+			return nil
+		}
+		if herr.IsBadRequest() {
+			// The API's message does not reliably say why, so look for an
+			// active version rather than matching on it.
+			if v, verr := c.ActiveVersion(&ActiveVersionInput{ServiceID: i.ID}); verr == nil {
+				return &ServiceActiveError{ServiceID: i.ID, ServiceVersion: v.Number, Err: err}
+			}
+		}
 		return err
 	}
 
@@ -217,13 +632,106 @@ func (c *Client) DeleteService(i *DeleteServiceInput) error {
 	return nil
 }
 
+// CreateServiceWithDomainInput is used as input to the CreateServiceWithDomain
+// function.
+type CreateServiceWithDomainInput struct {
+	// Name is the name of the service (required).
+	Name string
+
+	// Comment is a freeform descriptive note for the service.
+	Comment string
+
+	// Domain is the name of the domain the service will respond to
+	// (required).
+	Domain string
+
+	// Backend describes the backend to add (required). Its ServiceID and
+	// ServiceVersion are filled in; its Name must be set.
+	Backend *CreateBackendInput
+
+	// Activate activates the version once the domain and backend are added.
+	Activate bool
+}
+
+// CreateServiceWithDomain creates a VCL service and adds a domain and a
+// backend to its first version, activating it when Activate is set. It
+// returns the service and the version number that was configured.
+//
+// If any step fails, the partially created service is deleted on a best
+// effort basis and the error from the failed step is returned.
+func (c *Client) CreateServiceWithDomain(i *CreateServiceWithDomainInput) (*Service, int, error) {
+	if i.Name == "" {
+		return nil, 0, ErrMissingName
+	}
+
+	if i.Domain == "" {
+		return nil, 0, ErrMissingDomain
+	}
+
+	if i.Backend == nil || i.Backend.Name == "" {
+		return nil, 0, ErrMissingBackend
+	}
+
+	s, err := c.CreateService(&CreateServiceInput{
+		Name:    i.Name,
+		Type:    ServiceTypeVCL,
+		Comment: i.Comment,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if c.DryRun {
+		return nil, 0, ErrDryRun
+	}
+
+	// A new service always starts with version 1, which is not yet active.
+	const version = 1
+
+	cleanup := func(err error) (*Service, int, error) {
+		c.DeleteService(&DeleteServiceInput{ID: s.ID, IgnoreMissing: true})
+		return nil, 0, err
+	}
+
+	if _, err := c.CreateDomain(&CreateDomainInput{
+		ServiceID:      s.ID,
+		ServiceVersion: version,
+		Name:           i.Domain,
+	}); err != nil {
+		return cleanup(err)
+	}
+
+	b := *i.Backend
+	b.ServiceID = s.ID
+	b.ServiceVersion = version
+	if _, err := c.CreateBackend(&b); err != nil {
+		return cleanup(err)
+	}
+
+	if i.Activate {
+		if _, err := c.ActivateVersion(&ActivateVersionInput{
+			ServiceID:      s.ID,
+			ServiceVersion: version,
+		}); err != nil {
+			return cleanup(err)
+		}
+	}
+
+	return s, version, nil
+}
+
 // SearchServiceInput is used as input to the SearchService function.
 type SearchServiceInput struct {
 	Name string
+
+	// CaseInsensitive, when the exact-match search finds nothing, falls back
+	// to listing all services and matching Name case-insensitively.
+	CaseInsensitive bool
 }
 
-// SearchService gets a specific service by name. If no service exists by that
-// name, the API returns a 400 response (not a 404).
+// SearchService gets a specific service by name. The API matches names
+// exactly; set CaseInsensitive to fall back to a case-insensitive match
+// against ListServices. If no service is found, ErrServiceNotFound is
+// returned.
 func (c *Client) SearchService(i *SearchServiceInput) (*Service, error) {
 	if i.Name == "" {
 		return nil, ErrMissingName
@@ -235,7 +743,25 @@ func (c *Client) SearchService(i *SearchServiceInput) (*Service, error) {
 		},
 	})
 	if err != nil {
-		return nil, err
+		// The API responds with a 400 (not a 404) when no service matches.
+		herr, ok := err.(*HTTPError)
+		if !ok || !herr.isRecordNotFound() {
+			return nil, err
+		}
+		if !i.CaseInsensitive {
+			return nil, ErrServiceNotFound
+		}
+
+		services, err := c.ListServices(nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range services {
+			if strings.EqualFold(s.Name, i.Name) {
+				return s, nil
+			}
+		}
+		return nil, ErrServiceNotFound
 	}
 
 	var s *Service
@@ -246,26 +772,54 @@ func (c *Client) SearchService(i *SearchServiceInput) (*Service, error) {
 	return s, nil
 }
 
+// ListServiceDomainInput is the input parameter to the ListServiceDomains
+// function.
 type ListServiceDomainInput struct {
+	// ID is the ID of the service (required).
 	ID string
+
+	// ServiceVersion, when set, lists the domains of that version only.
+	// Otherwise the domains across all versions of the service are returned.
+	ServiceVersion int
+
+	// FilterByName, when set, restricts the results to the domain with this
+	// name.
+	FilterByName string
 }
 
-// ListServiceDomains lists all domains associated with a given service
+// ListServiceDomains lists the domains associated with a given service,
+// following the Link header through every page of results. Use UpdateDomain
+// to change the comment of a single domain.
 func (c *Client) ListServiceDomains(i *ListServiceDomainInput) (ServiceDomainsList, error) {
 	if i.ID == "" {
 		return nil, ErrMissingID
 	}
+
 	path := fmt.Sprintf("/service/%s/domain", i.ID)
-	resp, err := c.Get(path, nil)
-	if err != nil {
-		return nil, err
+	if i.ServiceVersion != 0 {
+		path = fmt.Sprintf("/service/%s/version/%d/domain", i.ID, i.ServiceVersion)
 	}
-
 	var ds ServiceDomainsList
-
-	if err := decodeBodyMap(resp.Body, &ds); err != nil {
+	if err := c.getAllPages(path, func(body io.ReadCloser) error {
+		var page ServiceDomainsList
+		if err := decodeBodyMap(body, &page); err != nil {
+			return err
+		}
+		ds = append(ds, page...)
+		return nil
+	}); err != nil {
 		return nil, err
 	}
 
+	if i.FilterByName != "" {
+		var filtered ServiceDomainsList
+		for _, d := range ds {
+			if d.Name == i.FilterByName {
+				filtered = append(filtered, d)
+			}
+		}
+		ds = filtered
+	}
+
 	return ds, nil
 }
@@ -1,7 +1,14 @@
 package fastly
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestClient_Services(t *testing.T) {
@@ -160,6 +167,79 @@ func TestClient_Services(t *testing.T) {
 	}
 }
 
+func TestClient_ListServices_pagination(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		if page == "1" {
+			w.Header().Set("Link", fmt.Sprintf(`<http://%s/service?page=2&per_page=100>; rel="next", <http://%s/service?page=2&per_page=100>; rel="last"`, r.Host, r.Host))
+			w.Write([]byte(`[{"id": "b", "name": "beta"}]`))
+			return
+		}
+		w.Write([]byte(`[{"id": "a", "name": "alpha"}]`))
+	})
+
+	ss, err := c.ListServices(&ListServicesInput{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ss) != 2 {
+		t.Fatalf("expected 2 services, got %d", len(ss))
+	}
+	if ss[0].Name != "alpha" || ss[1].Name != "beta" {
+		t.Errorf("bad order: %q, %q", ss[0].Name, ss[1].Name)
+	}
+}
+
+func TestClient_ListServices_nextWithoutLast(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("sort"); got != "created" {
+			t.Errorf("bad sort: %q", got)
+		}
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("Link", fmt.Sprintf(`<http://%s/service?page=2&per_page=100&sort=created>; rel="next"`, r.Host))
+			w.Write([]byte(`[{"id": "c", "name": "gamma"}]`))
+		case "2":
+			w.Header().Set("Link", fmt.Sprintf(`<http://%s/service?page=3&per_page=100&sort=created>; rel="next"`, r.Host))
+			w.Write([]byte(`[{"id": "a", "name": "alpha"}]`))
+		default:
+			w.Write([]byte(`[{"id": "b", "name": "beta"}]`))
+		}
+	})
+
+	ss, err := c.ListServices(&ListServicesInput{Sort: "created"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, s := range ss {
+		got = append(got, s.Name)
+	}
+	if strings.Join(got, ",") != "gamma,alpha,beta" {
+		t.Errorf("bad services: %v", got)
+	}
+}
+
+func TestClient_CreateService_validation(t *testing.T) {
+	var err error
+	_, err = testClient.CreateService(&CreateServiceInput{})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateService(&CreateServiceInput{
+		Name: "foo",
+		Type: "lambda",
+	})
+	if err != ErrInvalidServiceType {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
 func TestClient_GetService_validation(t *testing.T) {
 	var err error
 	_, err = testClient.GetService(&GetServiceInput{})
@@ -197,3 +277,488 @@ func TestClient_DeleteService_validation(t *testing.T) {
 		t.Errorf("bad error: %s", err)
 	}
 }
+
+func TestService_MarshalJSON(t *testing.T) {
+	created := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	b, err := json.Marshal(&Service{
+		ID:            "SERVICE_ID",
+		Name:          "my-service",
+		CreatedAt:     &created,
+		ActiveVersion: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got["id"] != "SERVICE_ID" || got["name"] != "my-service" {
+		t.Errorf("bad keys: %s", b)
+	}
+	if got["created_at"] != "2021-06-01T00:00:00Z" {
+		t.Errorf("bad created_at: %v", got["created_at"])
+	}
+	if _, ok := got["deleted_at"]; ok {
+		t.Errorf("nil deleted_at should be omitted: %s", b)
+	}
+}
+
+func TestClient_ListServiceDomains_versionAndFilter(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/service/foo/version/2/domain" {
+			t.Errorf("bad path: %s", r.URL.Path)
+		}
+		w.Write([]byte(`[{"name": "a.example.com", "version": 2}, {"name": "b.example.com", "version": 2}]`))
+	})
+
+	ds, err := c.ListServiceDomains(&ListServiceDomainInput{
+		ID:             "foo",
+		ServiceVersion: 2,
+		FilterByName:   "b.example.com",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ds) != 1 || ds[0].Name != "b.example.com" {
+		t.Errorf("bad domains: %v", ds)
+	}
+}
+
+func TestClient_GetAllServiceDetails(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/service":
+			w.Write([]byte(`[{"id": "c", "name": "gamma"}, {"id": "a", "name": "alpha"}, {"id": "b", "name": "beta"}]`))
+		case "/service/a/details":
+			w.Write([]byte(`{"id": "a", "name": "alpha"}`))
+		case "/service/b/details":
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"msg": "boom"}`))
+		case "/service/c/details":
+			w.Write([]byte(`{"id": "c", "name": "gamma"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	ds, err := c.GetAllServiceDetails(context.Background(), 2)
+	merr, ok := err.(*MultiError)
+	if !ok {
+		t.Fatalf("expected *MultiError, got %v", err)
+	}
+	if len(merr.Errors) != 1 || merr.Errors["b"] == nil {
+		t.Errorf("bad errors: %v", merr.Errors)
+	}
+	if len(ds) != 2 || ds[0].Name != "alpha" || ds[1].Name != "gamma" {
+		t.Errorf("bad details: %v", ds)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := c.GetAllServiceDetails(ctx, 2); err != context.Canceled {
+		t.Errorf("bad error: %v", err)
+	}
+}
+
+func TestClient_GetAllServiceDetails_cancelListing(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/service" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		// Hold the listing until the client gives up on it.
+		<-r.Context().Done()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if _, err := c.GetAllServiceDetails(ctx, 2); err != context.DeadlineExceeded {
+		t.Errorf("bad error: %v", err)
+	}
+	if d := time.Since(start); d > 5*time.Second {
+		t.Errorf("listing was not interrupted, took %s", d)
+	}
+}
+
+func TestClient_EnableServiceTypeChecks(t *testing.T) {
+	t.Parallel()
+
+	var lookups int
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/service/foo" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		lookups++
+		w.Write([]byte(`{"id":"foo","type":"wasm"}`))
+	})
+
+	// Without checks enabled no lookup is made.
+	if err := c.checkServiceType("foo", ServiceTypeVCL); err != nil {
+		t.Fatal(err)
+	}
+
+	c.EnableServiceTypeChecks()
+	for n := 0; n < 2; n++ {
+		_, err := c.CreateVCL(&CreateVCLInput{
+			ServiceID:      "foo",
+			ServiceVersion: 1,
+			Name:           "main",
+			Content:        "sub vcl_recv {}",
+		})
+		serr, ok := err.(*ServiceTypeError)
+		if !ok {
+			t.Fatalf("bad error: %v", err)
+		}
+		if serr.Type != ServiceTypeWasm || serr.Required != ServiceTypeVCL {
+			t.Errorf("bad error: %+v", serr)
+		}
+	}
+	if lookups != 1 {
+		t.Errorf("expected the service type to be cached, got %d lookups", lookups)
+	}
+}
+
+func TestClient_SearchService_caseInsensitive(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/service/search":
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"msg":"Record not found","detail":"Couldn't find Service"}`))
+		case "/service":
+			w.Write([]byte(`[{"id":"a","name":"other"},{"id":"b","name":"My-Service"}]`))
+		default:
+			t.Errorf("unexpected request: %s", r.URL.Path)
+		}
+	})
+
+	_, err := c.SearchService(&SearchServiceInput{Name: "my-service"})
+	if err != ErrServiceNotFound {
+		t.Errorf("bad error: %v", err)
+	}
+
+	s, err := c.SearchService(&SearchServiceInput{Name: "my-service", CaseInsensitive: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.ID != "b" {
+		t.Errorf("bad service: %q", s.ID)
+	}
+
+	_, err = c.SearchService(&SearchServiceInput{Name: "missing", CaseInsensitive: true})
+	if err != ErrServiceNotFound {
+		t.Errorf("bad error: %v", err)
+	}
+}
+
+func TestClient_SearchService_badRequest(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"msg":"Bad request","detail":"Invalid value for name"}`))
+	})
+
+	_, err := c.SearchService(&SearchServiceInput{Name: "my-service"})
+	herr, ok := err.(*HTTPError)
+	if !ok {
+		t.Fatalf("expected an HTTPError, got %v", err)
+	}
+	if !herr.IsBadRequest() {
+		t.Errorf("bad status: %d", herr.StatusCode)
+	}
+}
+
+func TestClient_DeleteService_errors(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodDelete && r.URL.Path == "/service/missing":
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"msg":"Record not found","detail":"Couldn't find Service"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/service/missing/version":
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"msg":"Record not found","detail":"Couldn't find Service"}`))
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"msg":"Bad request","detail":"Service is inactive"}`))
+		case r.URL.Path == "/service/active/version":
+			w.Write([]byte(`[{"number":1,"active":false},{"number":2,"active":true}]`))
+		case r.URL.Path == "/service/inactive/version":
+			w.Write([]byte(`[{"number":1,"active":false}]`))
+		}
+	})
+
+	err := c.DeleteService(&DeleteServiceInput{ID: "missing"})
+	if herr, ok := err.(*HTTPError); !ok || !herr.IsBadRequest() {
+		t.Errorf("bad error: %v", err)
+	}
+
+	if err := c.DeleteService(&DeleteServiceInput{ID: "missing", IgnoreMissing: true}); err != nil {
+		t.Errorf("bad error: %v", err)
+	}
+
+	err = c.DeleteService(&DeleteServiceInput{ID: "active"})
+	var aerr *ServiceActiveError
+	if !errors.Is(err, ErrServiceActive) || !errors.As(err, &aerr) || aerr.ServiceVersion != 2 {
+		t.Errorf("bad error: %v", err)
+	}
+	var herr *HTTPError
+	if !errors.As(err, &herr) || !herr.IsBadRequest() {
+		t.Errorf("API error not wrapped: %v", err)
+	}
+
+	// A message mentioning "inactive" does not make the service active.
+	err = c.DeleteService(&DeleteServiceInput{ID: "inactive"})
+	if errors.Is(err, ErrServiceActive) {
+		t.Errorf("bad error: %v", err)
+	}
+}
+
+func TestClient_ListServiceDomains_unversioned(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/service/foo/domain" {
+			t.Errorf("bad path: %s", r.URL.Path)
+		}
+		w.Write([]byte(`[{"name": "a.example.com", "version": 1}, {"name": "a.example.com", "version": 2}]`))
+	})
+
+	ds, err := c.ListServiceDomains(&ListServiceDomainInput{ID: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ds) != 2 {
+		t.Errorf("bad domains: %v", ds)
+	}
+}
+
+func TestClient_CreateService_returnExistingOnConflict(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service":
+			r.ParseForm()
+			if _, ok := r.PostForm["ReturnExistingOnConflict"]; ok {
+				t.Errorf("option sent to the API: %v", r.PostForm)
+			}
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"msg":"Duplicate record","detail":"Service name already exists"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/service/search":
+			w.Write([]byte(`{"id":"foo","name":"my-service"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	if _, err := c.CreateService(&CreateServiceInput{Name: "my-service"}); err == nil {
+		t.Error("expected error without ReturnExistingOnConflict")
+	}
+
+	s, err := c.CreateService(&CreateServiceInput{
+		Name:                     "my-service",
+		ReturnExistingOnConflict: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.ID != "foo" || !s.Existing {
+		t.Errorf("bad service: %+v", s)
+	}
+}
+
+func TestClient_CreateServiceWithDomain(t *testing.T) {
+	t.Parallel()
+
+	var calls []string
+	failBackend := false
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch r.Method + " " + r.URL.Path {
+		case "POST /service":
+			w.Write([]byte(`{"id":"foo","name":"my-service","type":"vcl"}`))
+		case "POST /service/foo/version/1/domain":
+			w.Write([]byte(`{"name":"example.com"}`))
+		case "POST /service/foo/version/1/backend":
+			if failBackend {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"msg":"Bad request","detail":"invalid address"}`))
+				return
+			}
+			w.Write([]byte(`{"name":"origin"}`))
+		case "PUT /service/foo/version/1/activate":
+			w.Write([]byte(`{"number":1,"active":true}`))
+		case "DELETE /service/foo":
+			w.Write([]byte(`{"status":"ok"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	s, v, err := c.CreateServiceWithDomain(&CreateServiceWithDomainInput{
+		Name:     "my-service",
+		Domain:   "example.com",
+		Backend:  &CreateBackendInput{Name: "origin", Address: "origin.example.com"},
+		Activate: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.ID != "foo" || v != 1 {
+		t.Errorf("bad result: %+v, %d", s, v)
+	}
+	want := "POST /service,POST /service/foo/version/1/domain,POST /service/foo/version/1/backend,PUT /service/foo/version/1/activate"
+	if got := strings.Join(calls, ","); got != want {
+		t.Errorf("bad calls: %s", got)
+	}
+
+	calls = nil
+	failBackend = true
+	if _, _, err := c.CreateServiceWithDomain(&CreateServiceWithDomainInput{
+		Name:    "my-service",
+		Domain:  "example.com",
+		Backend: &CreateBackendInput{Name: "origin", Address: "bad"},
+	}); err == nil {
+		t.Error("expected error")
+	}
+	if last := calls[len(calls)-1]; last != "DELETE /service/foo" {
+		t.Errorf("service not cleaned up: %v", calls)
+	}
+}
+
+func TestClient_CreateServiceWithDomain_validation(t *testing.T) {
+	var err error
+	_, _, err = testClient.CreateServiceWithDomain(&CreateServiceWithDomainInput{
+		Domain:  "example.com",
+		Backend: &CreateBackendInput{Name: "origin"},
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, _, err = testClient.CreateServiceWithDomain(&CreateServiceWithDomainInput{
+		Name:    "my-service",
+		Backend: &CreateBackendInput{Name: "origin"},
+	})
+	if err != ErrMissingDomain {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, _, err = testClient.CreateServiceWithDomain(&CreateServiceWithDomainInput{
+		Name:   "my-service",
+		Domain: "example.com",
+	})
+	if err != ErrMissingBackend {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_GetServiceDetails_version(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/service/foo/details" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		v := r.URL.Query().Get("version")
+		if v == "" {
+			v = "1"
+		}
+		fmt.Fprintf(w, `{"id":"foo","active_version":{"number":1,"active":true},"version":{"number":%s}}`, v)
+	})
+
+	d, err := c.GetServiceDetails(&GetServiceInput{ID: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Version.Number != 1 {
+		t.Errorf("bad version: %d", d.Version.Number)
+	}
+
+	d, err = c.GetServiceDetails(&GetServiceInput{ID: "foo", Version: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Version.Number != 3 || d.ActiveVersion.Number != 1 {
+		t.Errorf("bad versions: %d, %d", d.Version.Number, d.ActiveVersion.Number)
+	}
+}
+
+func TestClient_ListServiceDomains_pages(t *testing.T) {
+	t.Parallel()
+
+	var loop bool
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/service/foo/domain" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		switch page := r.URL.Query().Get("page"); {
+		case loop:
+			w.Header().Set("Link", `<https://api.fastly.com/service/foo/domain?page=2>; rel="next"`)
+			w.Write([]byte(`[]`))
+		case page == "":
+			w.Header().Set("Link", `<https://api.fastly.com/service/foo/domain?page=2>; rel="next", <https://api.fastly.com/service/foo/domain?page=2>; rel="last"`)
+			w.Write([]byte(`[{"name":"a.example.com","version":1}]`))
+		case page == "2":
+			w.Write([]byte(`[{"name":"b.example.com","version":1}]`))
+		}
+	})
+
+	ds, err := c.ListServiceDomains(&ListServiceDomainInput{ID: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ds) != 2 || ds[0].Name != "a.example.com" || ds[1].Name != "b.example.com" {
+		t.Errorf("bad domains: %v", ds)
+	}
+
+	loop = true
+	if _, err := c.ListServiceDomains(&ListServiceDomainInput{ID: "foo"}); err != ErrTooManyPages {
+		t.Errorf("bad error: %v", err)
+	}
+}
+
+func TestClient_ListServices_filters(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[
+			{"id":"a","name":"Prod-Edge","type":"vcl"},
+			{"id":"b","name":"prod-compute","type":"wasm"},
+			{"id":"c","name":"staging-edge","type":"vcl"}
+		]`))
+	})
+
+	ss, err := c.ListServices(&ListServicesInput{NameContains: "PROD"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ss) != 2 || ss[0].ID != "a" || ss[1].ID != "b" {
+		t.Errorf("bad services: %v", ss)
+	}
+
+	ss, err = c.ListServices(&ListServicesInput{Type: ServiceTypeVCL, NameContains: "edge"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ss) != 2 || ss[0].ID != "a" || ss[1].ID != "c" {
+		t.Errorf("bad services: %v", ss)
+	}
+
+	if _, err := c.ListServices(&ListServicesInput{Type: "bogus"}); err != ErrInvalidServiceType {
+		t.Errorf("bad error: %v", err)
+	}
+}
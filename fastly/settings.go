@@ -2,15 +2,15 @@ package fastly
 
 import "fmt"
 
-// Settings represents a backend response from the Fastly API.
+// Settings represents the general settings of a service version.
 type Settings struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
 
-	DefaultTTL      uint   `mapstructure:"general.default_ttl"`
-	DefaultHost     string `mapstructure:"general.default_host"`
-	StaleIfError    bool   `mapstructure:"general.stale_if_error"`
-	StaleIfErrorTTL uint   `mapstructure:"general.stale_if_error_ttl"`
+	DefaultTTL      uint   `mapstructure:"general.default_ttl" json:"general.default_ttl"`
+	DefaultHost     string `mapstructure:"general.default_host" json:"general.default_host"`
+	StaleIfError    bool   `mapstructure:"general.stale_if_error" json:"general.stale_if_error"`
+	StaleIfErrorTTL uint   `mapstructure:"general.stale_if_error_ttl" json:"general.stale_if_error_ttl"`
 }
 
 // GetSettingsInput is used as input to the GetSettings function.
@@ -22,7 +22,7 @@ type GetSettingsInput struct {
 	ServiceVersion int
 }
 
-// GetSettings gets the backend configuration with the given parameters.
+// GetSettings gets the general settings of the given service version.
 func (c *Client) GetSettings(i *GetSettingsInput) (*Settings, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
@@ -53,13 +53,15 @@ type UpdateSettingsInput struct {
 	// ServiceVersion is the specific configuration version (required).
 	ServiceVersion int
 
-	DefaultTTL      uint    `url:"general.default_ttl"`
+	// DefaultTTL is sent whenever it is set, so that it can be set to 0.
+	DefaultTTL      *uint   `url:"general.default_ttl,omitempty"`
 	DefaultHost     *string `url:"general.default_host,omitempty"`
 	StaleIfError    *bool   `url:"general.stale_if_error,omitempty"`
 	StaleIfErrorTTL *uint   `url:"general.stale_if_error_ttl,omitempty"`
 }
 
-// UpdateSettings updates a specific backend.
+// UpdateSettings updates the general settings of the given service version.
+// Fields left nil are not changed.
 func (c *Client) UpdateSettings(i *UpdateSettingsInput) (*Settings, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
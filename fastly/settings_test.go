@@ -36,7 +36,7 @@ func TestClient_Settings(t *testing.T) {
 		us, err = c.UpdateSettings(&UpdateSettingsInput{
 			ServiceID:       testServiceID,
 			ServiceVersion:  tv.Number,
-			DefaultTTL:      1800,
+			DefaultTTL:      Uint(1800),
 			StaleIfError:    Bool(true),
 			StaleIfErrorTTL: Uint(57600),
 		})
@@ -58,7 +58,7 @@ func TestClient_Settings(t *testing.T) {
 // Tests if we can update a default_ttl to 0 as reported in issue #20
 func TestClient_UpdateSettingsInput_default_ttl(t *testing.T) {
 	t.Parallel()
-	s := UpdateSettingsInput{ServiceID: "foo", ServiceVersion: 1, DefaultTTL: 0}
+	s := UpdateSettingsInput{ServiceID: "foo", ServiceVersion: 1, DefaultTTL: Uint(0)}
 
 	v, err := query.Values(s)
 	if err != nil {
@@ -69,6 +69,17 @@ func TestClient_UpdateSettingsInput_default_ttl(t *testing.T) {
 	if body != "ServiceID=foo&ServiceVersion=1&general.default_ttl=0" {
 		t.Errorf("Update request should contain a default_ttl. Got: %s", body)
 	}
+
+	s.DefaultTTL = nil
+	v, err = query.Values(s)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	body = v.Encode()
+
+	if body != "ServiceID=foo&ServiceVersion=1" {
+		t.Errorf("Update request should not contain a default_ttl. Got: %s", body)
+	}
 }
 
 func TestClient_GetSettings_validation(t *testing.T) {
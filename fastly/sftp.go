@@ -9,30 +9,30 @@ import (
 
 // SFTP represents an SFTP logging response from the Fastly API.
 type SFTP struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name              string     `mapstructure:"name"`
-	Address           string     `mapstructure:"address"`
-	Port              uint       `mapstructure:"port"`
-	User              string     `mapstructure:"user"`
-	Password          string     `mapstructure:"password"`
-	PublicKey         string     `mapstructure:"public_key"`
-	SecretKey         string     `mapstructure:"secret_key"`
-	SSHKnownHosts     string     `mapstructure:"ssh_known_hosts"`
-	Path              string     `mapstructure:"path"`
-	Period            uint       `mapstructure:"period"`
-	CompressionCodec  string     `mapstructure:"compression_codec"`
-	GzipLevel         uint8      `mapstructure:"gzip_level"`
-	Format            string     `mapstructure:"format"`
-	FormatVersion     uint       `mapstructure:"format_version"`
-	ResponseCondition string     `mapstructure:"response_condition"`
-	TimestampFormat   string     `mapstructure:"timestamp_format"`
-	MessageType       string     `mapstructure:"message_type"`
-	Placement         string     `mapstructure:"placement"`
-	CreatedAt         *time.Time `mapstructure:"created_at"`
-	UpdatedAt         *time.Time `mapstructure:"updated_at"`
-	DeletedAt         *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name              string     `mapstructure:"name" json:"name"`
+	Address           string     `mapstructure:"address" json:"address"`
+	Port              uint       `mapstructure:"port" json:"port"`
+	User              string     `mapstructure:"user" json:"user"`
+	Password          string     `mapstructure:"password" json:"password"`
+	PublicKey         string     `mapstructure:"public_key" json:"public_key"`
+	SecretKey         string     `mapstructure:"secret_key" json:"secret_key"`
+	SSHKnownHosts     string     `mapstructure:"ssh_known_hosts" json:"ssh_known_hosts"`
+	Path              string     `mapstructure:"path" json:"path"`
+	Period            uint       `mapstructure:"period" json:"period"`
+	CompressionCodec  string     `mapstructure:"compression_codec" json:"compression_codec"`
+	GzipLevel         uint8      `mapstructure:"gzip_level" json:"gzip_level"`
+	Format            string     `mapstructure:"format" json:"format"`
+	FormatVersion     uint       `mapstructure:"format_version" json:"format_version"`
+	ResponseCondition string     `mapstructure:"response_condition" json:"response_condition"`
+	TimestampFormat   string     `mapstructure:"timestamp_format" json:"timestamp_format"`
+	MessageType       string     `mapstructure:"message_type" json:"message_type"`
+	Placement         string     `mapstructure:"placement" json:"placement"`
+	CreatedAt         *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // sftpsByName is a sortable list of sftps.
@@ -106,14 +106,29 @@ type CreateSFTPInput struct {
 	Placement         string `url:"placement,omitempty"`
 }
 
-// CreateSFTP creates a new Fastly SFTP.
+// CreateSFTP creates a new Fastly SFTP. Either Password or SecretKey must be
+// set, along with SSHKnownHosts. Port defaults to 22 when unset.
 func (c *Client) CreateSFTP(i *CreateSFTPInput) (*SFTP, error) {
-	if i.ServiceID == "" {
-		return nil, ErrMissingServiceID
+	if err := validateServiceVersionName(i.ServiceID, i.ServiceVersion, i.Name); err != nil {
+		return nil, err
 	}
 
-	if i.ServiceVersion == 0 {
-		return nil, ErrMissingServiceVersion
+	if i.Password == "" && i.SecretKey == "" {
+		return nil, ErrMissingPasswordOrSecretKey
+	}
+
+	if i.SSHKnownHosts == "" {
+		return nil, ErrMissingSSHKnownHosts
+	}
+
+	if i.GzipLevel != 0 && i.CompressionCodec != "" {
+		return nil, ErrGzipLevelWithCompressionCodec
+	}
+
+	if i.Port == 0 {
+		in := *i
+		in.Port = 22
+		i = &in
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/logging/sftp", i.ServiceID, i.ServiceVersion)
@@ -213,6 +228,14 @@ func (c *Client) UpdateSFTP(i *UpdateSFTPInput) (*SFTP, error) {
 		return nil, ErrMissingName
 	}
 
+	if i.SSHKnownHosts != nil && *i.SSHKnownHosts == "" {
+		return nil, ErrMissingSSHKnownHosts
+	}
+
+	if i.GzipLevel != nil && *i.GzipLevel != 0 && i.CompressionCodec != nil && *i.CompressionCodec != "" {
+		return nil, ErrGzipLevelWithCompressionCodec
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/logging/sftp/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
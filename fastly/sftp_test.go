@@ -412,6 +412,35 @@ func TestClient_CreateSFTP_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateSFTP(&CreateSFTPInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateSFTP(&CreateSFTPInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		SSHKnownHosts:  "example.com ssh-ed25519 AAAA",
+	})
+	if err != ErrMissingPasswordOrSecretKey {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateSFTP(&CreateSFTPInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Password:       "password",
+	})
+	if err != ErrMissingSSHKnownHosts {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetSFTP_validation(t *testing.T) {
@@ -466,6 +495,16 @@ func TestClient_UpdateSFTP_validation(t *testing.T) {
 	if err != ErrMissingName {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.UpdateSFTP(&UpdateSFTPInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		SSHKnownHosts:  String(""),
+	})
+	if err != ErrMissingSSHKnownHosts {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_DeleteSFTP_validation(t *testing.T) {
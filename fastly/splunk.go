@@ -9,26 +9,26 @@ import (
 
 // Splunk represents a splunk response from the Fastly API.
 type Splunk struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name              string     `mapstructure:"name"`
-	URL               string     `mapstructure:"url"`
-	RequestMaxEntries uint       `mapstructure:"request_max_entries"`
-	RequestMaxBytes   uint       `mapstructure:"request_max_bytes"`
-	Format            string     `mapstructure:"format"`
-	FormatVersion     uint       `mapstructure:"format_version"`
-	ResponseCondition string     `mapstructure:"response_condition"`
-	Placement         string     `mapstructure:"placement"`
-	Token             string     `mapstructure:"token"`
-	UseTLS            bool       `mapstructure:"use_tls"`
-	TLSCACert         string     `mapstructure:"tls_ca_cert"`
-	TLSHostname       string     `mapstructure:"tls_hostname"`
-	TLSClientCert     string     `mapstructure:"tls_client_cert"`
-	TLSClientKey      string     `mapstructure:"tls_client_key"`
-	CreatedAt         *time.Time `mapstructure:"created_at"`
-	UpdatedAt         *time.Time `mapstructure:"updated_at"`
-	DeletedAt         *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name              string     `mapstructure:"name" json:"name"`
+	URL               string     `mapstructure:"url" json:"url"`
+	RequestMaxEntries uint       `mapstructure:"request_max_entries" json:"request_max_entries"`
+	RequestMaxBytes   uint       `mapstructure:"request_max_bytes" json:"request_max_bytes"`
+	Format            string     `mapstructure:"format" json:"format"`
+	FormatVersion     uint       `mapstructure:"format_version" json:"format_version"`
+	ResponseCondition string     `mapstructure:"response_condition" json:"response_condition"`
+	Placement         string     `mapstructure:"placement" json:"placement"`
+	Token             string     `mapstructure:"token" json:"token"`
+	UseTLS            bool       `mapstructure:"use_tls" json:"use_tls"`
+	TLSCACert         string     `mapstructure:"tls_ca_cert" json:"tls_ca_cert"`
+	TLSHostname       string     `mapstructure:"tls_hostname" json:"tls_hostname"`
+	TLSClientCert     string     `mapstructure:"tls_client_cert" json:"tls_client_cert"`
+	TLSClientKey      string     `mapstructure:"tls_client_key" json:"tls_client_key"`
+	CreatedAt         *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // splunkByName is a sortable list of splunks.
@@ -111,3 +111,26 @@ func TestClient_GetServicesByRegionsUsage(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestClient_GetStats_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetStats(&GetStatsInput{
+		By: "week",
+	})
+	if err != ErrInvalidBy {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestStatsParams(t *testing.T) {
+	params, err := statsParams("2021-06-01T00:00:00Z", "now", "day", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if params["from"] != "1622505600" {
+		t.Errorf("bad from: %q", params["from"])
+	}
+	if params["to"] != "now" {
+		t.Errorf("bad to: %q", params["to"])
+	}
+}
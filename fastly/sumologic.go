@@ -9,20 +9,20 @@ import (
 
 // Sumologic represents a sumologic response from the Fastly API.
 type Sumologic struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name              string     `mapstructure:"name"`
-	Address           string     `mapstructure:"address"`
-	URL               string     `mapstructure:"url"`
-	Format            string     `mapstructure:"format"`
-	ResponseCondition string     `mapstructure:"response_condition"`
-	MessageType       string     `mapstructure:"message_type"`
-	FormatVersion     int        `mapstructure:"format_version"`
-	CreatedAt         *time.Time `mapstructure:"created_at"`
-	UpdatedAt         *time.Time `mapstructure:"updated_at"`
-	DeletedAt         *time.Time `mapstructure:"deleted_at"`
-	Placement         string     `mapstructure:"placement"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name              string     `mapstructure:"name" json:"name"`
+	Address           string     `mapstructure:"address" json:"address"`
+	URL               string     `mapstructure:"url" json:"url"`
+	Format            string     `mapstructure:"format" json:"format"`
+	ResponseCondition string     `mapstructure:"response_condition" json:"response_condition"`
+	MessageType       string     `mapstructure:"message_type" json:"message_type"`
+	FormatVersion     int        `mapstructure:"format_version" json:"format_version"`
+	CreatedAt         *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
+	Placement         string     `mapstructure:"placement" json:"placement"`
 }
 
 // sumologicsByName is a sortable list of sumologics.
@@ -96,6 +96,18 @@ func (c *Client) CreateSumologic(i *CreateSumologicInput) (*Sumologic, error) {
 		return nil, ErrMissingServiceVersion
 	}
 
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	if !validFormatVersion(i.FormatVersion) {
+		return nil, ErrInvalidFormatVersion
+	}
+
+	if !validMessageType(i.MessageType) {
+		return nil, ErrInvalidMessageType
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/logging/sumologic", i.ServiceID, i.ServiceVersion)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
@@ -183,6 +195,14 @@ func (c *Client) UpdateSumologic(i *UpdateSumologicInput) (*Sumologic, error) {
 		return nil, ErrMissingName
 	}
 
+	if i.FormatVersion != nil && !validFormatVersion(*i.FormatVersion) {
+		return nil, ErrInvalidFormatVersion
+	}
+
+	if i.MessageType != nil && !validMessageType(*i.MessageType) {
+		return nil, ErrInvalidMessageType
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/logging/sumologic/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
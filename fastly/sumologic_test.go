@@ -177,6 +177,35 @@ func TestClient_CreateSumologic_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateSumologic(&CreateSumologicInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateSumologic(&CreateSumologicInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		FormatVersion:  3,
+	})
+	if err != ErrInvalidFormatVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateSumologic(&CreateSumologicInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		MessageType:    "syslog",
+	})
+	if err != ErrInvalidMessageType {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetSumologic_validation(t *testing.T) {
@@ -231,6 +260,16 @@ func TestClient_UpdateSumologic_validation(t *testing.T) {
 	if err != ErrMissingName {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.UpdateSumologic(&UpdateSumologicInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		FormatVersion:  Int(3),
+	})
+	if err != ErrInvalidFormatVersion {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_DeleteSumologic_validation(t *testing.T) {
@@ -9,28 +9,28 @@ import (
 
 // Syslog represents a syslog response from the Fastly API.
 type Syslog struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name              string     `mapstructure:"name"`
-	Address           string     `mapstructure:"address"`
-	Hostname          string     `mapstructure:"hostname"`
-	Port              uint       `mapstructure:"port"`
-	UseTLS            bool       `mapstructure:"use_tls"`
-	IPV4              string     `mapstructure:"ipv4"`
-	TLSCACert         string     `mapstructure:"tls_ca_cert"`
-	TLSHostname       string     `mapstructure:"tls_hostname"`
-	TLSClientCert     string     `mapstructure:"tls_client_cert"`
-	TLSClientKey      string     `mapstructure:"tls_client_key"`
-	Token             string     `mapstructure:"token"`
-	Format            string     `mapstructure:"format"`
-	FormatVersion     uint       `mapstructure:"format_version"`
-	MessageType       string     `mapstructure:"message_type"`
-	ResponseCondition string     `mapstructure:"response_condition"`
-	Placement         string     `mapstructure:"placement"`
-	CreatedAt         *time.Time `mapstructure:"created_at"`
-	UpdatedAt         *time.Time `mapstructure:"updated_at"`
-	DeletedAt         *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name              string     `mapstructure:"name" json:"name"`
+	Address           string     `mapstructure:"address" json:"address"`
+	Hostname          string     `mapstructure:"hostname" json:"hostname"`
+	Port              uint       `mapstructure:"port" json:"port"`
+	UseTLS            bool       `mapstructure:"use_tls" json:"use_tls"`
+	IPV4              string     `mapstructure:"ipv4" json:"ipv4"`
+	TLSCACert         string     `mapstructure:"tls_ca_cert" json:"tls_ca_cert"`
+	TLSHostname       string     `mapstructure:"tls_hostname" json:"tls_hostname"`
+	TLSClientCert     string     `mapstructure:"tls_client_cert" json:"tls_client_cert"`
+	TLSClientKey      string     `mapstructure:"tls_client_key" json:"tls_client_key"`
+	Token             string     `mapstructure:"token" json:"token"`
+	Format            string     `mapstructure:"format" json:"format"`
+	FormatVersion     uint       `mapstructure:"format_version" json:"format_version"`
+	MessageType       string     `mapstructure:"message_type" json:"message_type"`
+	ResponseCondition string     `mapstructure:"response_condition" json:"response_condition"`
+	Placement         string     `mapstructure:"placement" json:"placement"`
+	CreatedAt         *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // syslogsByName is a sortable list of syslogs.
@@ -102,7 +102,7 @@ type CreateSyslogInput struct {
 	Placement         string      `url:"placement,omitempty"`
 }
 
-// CreateSyslog creates a new Fastly syslog.
+// CreateSyslog creates a new Fastly syslog. Port defaults to 514 when unset.
 func (c *Client) CreateSyslog(i *CreateSyslogInput) (*Syslog, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
@@ -112,6 +112,20 @@ func (c *Client) CreateSyslog(i *CreateSyslogInput) (*Syslog, error) {
 		return nil, ErrMissingServiceVersion
 	}
 
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	if !validMessageType(i.MessageType) {
+		return nil, ErrInvalidMessageType
+	}
+
+	if i.Port == 0 {
+		in := *i
+		in.Port = 514
+		i = &in
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/logging/syslog", i.ServiceID, i.ServiceVersion)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
@@ -207,6 +221,10 @@ func (c *Client) UpdateSyslog(i *UpdateSyslogInput) (*Syslog, error) {
 		return nil, ErrMissingName
 	}
 
+	if i.MessageType != nil && !validMessageType(*i.MessageType) {
+		return nil, ErrInvalidMessageType
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/logging/syslog/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
@@ -259,6 +259,25 @@ func TestClient_CreateSyslog_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateSyslog(&CreateSyslogInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateSyslog(&CreateSyslogInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		MessageType:    "json",
+	})
+	if err != ErrInvalidMessageType {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetSyslog_validation(t *testing.T) {
@@ -313,6 +332,16 @@ func TestClient_UpdateSyslog_validation(t *testing.T) {
 	if err != ErrMissingName {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.UpdateSyslog(&UpdateSyslogInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		MessageType:    String("json"),
+	})
+	if err != ErrInvalidMessageType {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_DeleteSyslog_validation(t *testing.T) {
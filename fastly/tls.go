@@ -1,6 +1,10 @@
 package fastly
 
 import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"reflect"
 	"strconv"
@@ -113,6 +117,7 @@ func (c *Client) GetPrivateKey(i *GetPrivateKeyInput) (*PrivateKey, error) {
 }
 
 // CreatePrivateKeyInput is used as input to the CreatePrivateKey function.
+// Key must be a PEM-encoded RSA or EC private key.
 type CreatePrivateKeyInput struct {
 	Key  string `jsonapi:"attr,key,omitempty"`
 	Name string `jsonapi:"attr,name,omitempty"`
@@ -131,6 +136,10 @@ func (c *Client) CreatePrivateKey(i *CreatePrivateKeyInput) (*PrivateKey, error)
 		return nil, ErrMissingName
 	}
 
+	if !validPrivateKey(i.Key) {
+		return nil, ErrInvalidKey
+	}
+
 	r, err := c.PostJSONAPI(p, i, nil)
 	if err != nil {
 		return nil, err
@@ -144,6 +153,31 @@ func (c *Client) CreatePrivateKey(i *CreatePrivateKeyInput) (*PrivateKey, error)
 	return &ppk, nil
 }
 
+// validPrivateKey reports whether s holds a PEM-encoded RSA or EC private key
+// in PKCS #1, SEC 1 or PKCS #8 form.
+func validPrivateKey(s string) bool {
+	b, _ := pem.Decode([]byte(s))
+	if b == nil {
+		return false
+	}
+
+	if _, err := x509.ParsePKCS1PrivateKey(b.Bytes); err == nil {
+		return true
+	}
+	if _, err := x509.ParseECPrivateKey(b.Bytes); err == nil {
+		return true
+	}
+	k, err := x509.ParsePKCS8PrivateKey(b.Bytes)
+	if err != nil {
+		return false
+	}
+	switch k.(type) {
+	case *rsa.PrivateKey, *ecdsa.PrivateKey:
+		return true
+	}
+	return false
+}
+
 // DeletePrivateKeyInput used for deleting a private key.
 type DeletePrivateKeyInput struct {
 	ID string
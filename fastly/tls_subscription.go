@@ -9,7 +9,38 @@ import (
 	"github.com/google/jsonapi"
 )
 
-// TLSSubscription represents a managed TLS certificate
+const (
+	// TLSSubscriptionCertificateAuthorityLetsEncrypt issues certificates through Let's Encrypt.
+	TLSSubscriptionCertificateAuthorityLetsEncrypt = "lets-encrypt"
+	// TLSSubscriptionCertificateAuthorityGlobalSign issues certificates through GlobalSign.
+	TLSSubscriptionCertificateAuthorityGlobalSign = "globalsign"
+	// TLSSubscriptionCertificateAuthorityCertainly issues certificates through Certainly.
+	TLSSubscriptionCertificateAuthorityCertainly = "certainly"
+)
+
+const (
+	// TLSSubscriptionStatePending is a subscription awaiting domain validation.
+	TLSSubscriptionStatePending = "pending"
+	// TLSSubscriptionStateProcessing is a subscription whose certificate is being issued.
+	TLSSubscriptionStateProcessing = "processing"
+	// TLSSubscriptionStateIssued is a subscription with an issued certificate.
+	TLSSubscriptionStateIssued = "issued"
+	// TLSSubscriptionStateRenewing is a subscription whose certificate is being renewed.
+	TLSSubscriptionStateRenewing = "renewing"
+)
+
+// validCertificateAuthority reports whether ca is empty (letting the API pick
+// its default) or a supported certificate authority.
+func validCertificateAuthority(ca string) bool {
+	switch ca {
+	case "", TLSSubscriptionCertificateAuthorityLetsEncrypt, TLSSubscriptionCertificateAuthorityGlobalSign, TLSSubscriptionCertificateAuthorityCertainly:
+		return true
+	}
+	return false
+}
+
+// TLSSubscription represents a managed TLS certificate. State is one of the
+// TLSSubscriptionState* values.
 type TLSSubscription struct {
 	ID                   string                        `jsonapi:"primary,tls_subscription"`
 	CertificateAuthority string                        `jsonapi:"attr,certificate_authority"`
@@ -23,6 +54,7 @@ type TLSSubscription struct {
 	Authorizations       []*TLSAuthorizations          `jsonapi:"relation,tls_authorizations"`
 }
 
+// TLSSubscriptionCertificate is a certificate issued for a TLSSubscription.
 type TLSSubscriptionCertificate struct {
 	ID string `jsonapi:"primary,tls_certificate"`
 }
@@ -122,7 +154,7 @@ func (c *Client) ListTLSSubscriptions(i *ListTLSSubscriptionsInput) ([]*TLSSubsc
 type CreateTLSSubscriptionInput struct {
 	// ID value is ignored and should not be set, needed to make JSONAPI work correctly.
 	ID string `jsonapi:"primary,tls_subscription"`
-	// CertificateAuthority is the entity that issues and certifies the TLS certificates for your subscription. Valid values are lets-encrypt or globalsign.
+	// CertificateAuthority is the entity that issues and certifies the TLS certificates for your subscription. Valid values are lets-encrypt, globalsign or certainly.
 	CertificateAuthority string `jsonapi:"attr,certificate_authority,omitempty"`
 	// Configuration options that apply to the enabled domains on this subscription. Only ID needs to be populated
 	Configuration *TLSConfiguration `jsonapi:"relation,tls_configuration,omitempty"`
@@ -132,10 +164,16 @@ type CreateTLSSubscriptionInput struct {
 	Domains []*TLSDomain `jsonapi:"relation,tls_domain"`
 }
 
+// CreateTLSSubscription creates a managed TLS subscription for the given
+// domains. The returned subscription starts in the pending state until domain
+// ownership has been verified.
 func (c *Client) CreateTLSSubscription(i *CreateTLSSubscriptionInput) (*TLSSubscription, error) {
 	if len(i.Domains) == 0 {
 		return nil, ErrMissingTLSDomain
 	}
+	if !validCertificateAuthority(i.CertificateAuthority) {
+		return nil, ErrInvalidCertificateAuthority
+	}
 	if i.CommonName != nil && !domainInSlice(i.Domains, i.CommonName) {
 		return nil, ErrCommonNameNotInDomains
 	}
@@ -174,6 +212,7 @@ type GetTLSSubscriptionInput struct {
 	Include *string
 }
 
+// GetTLSSubscription retrieves a single TLS subscription.
 func (c *Client) GetTLSSubscription(i *GetTLSSubscriptionInput) (*TLSSubscription, error) {
 	if i.ID == "" {
 		return nil, ErrMissingID
@@ -205,6 +244,35 @@ func (c *Client) GetTLSSubscription(i *GetTLSSubscriptionInput) (*TLSSubscriptio
 	return &subscription, nil
 }
 
+// GetTLSSubscriptionAuthorizationsInput is used as input to the
+// GetTLSSubscriptionAuthorizations function.
+type GetTLSSubscriptionAuthorizationsInput struct {
+	// ID of the TLS subscription whose authorizations to fetch.
+	ID string
+}
+
+// GetTLSSubscriptionAuthorizations returns the domain ownership authorizations
+// of a TLS subscription, including the DNS and HTTP challenge records that need
+// to be put in place before a certificate can be issued.
+func (c *Client) GetTLSSubscriptionAuthorizations(i *GetTLSSubscriptionAuthorizationsInput) ([]*TLSAuthorizations, error) {
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	include := "tls_authorizations"
+	subscription, err := c.GetTLSSubscription(&GetTLSSubscriptionInput{
+		ID:      i.ID,
+		Include: &include,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if c.DryRun {
+		return nil, ErrDryRun
+	}
+	return subscription.Authorizations, nil
+}
+
 // UpdateTLSSubscriptionInput is used as input to the UpdateTLSSubscription function (Limited Availability)
 type UpdateTLSSubscriptionInput struct {
 	// ID of the subscription to update.
@@ -256,6 +324,7 @@ type DeleteTLSSubscriptionInput struct {
 	Force bool
 }
 
+// DeleteTLSSubscription deletes a TLS subscription.
 func (c *Client) DeleteTLSSubscription(i *DeleteTLSSubscriptionInput) error {
 	if i.ID == "" {
 		return ErrMissingID
@@ -1,6 +1,9 @@
 package fastly
 
-import "testing"
+import (
+	"net/http"
+	"testing"
+)
 
 const fixtureBase = "tls_subscription/"
 
@@ -144,6 +147,14 @@ func TestClient_CreateTLSSubscription_validation(t *testing.T) {
 	if err != ErrCommonNameNotInDomains {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateTLSSubscription(&CreateTLSSubscriptionInput{
+		Domains:              []*TLSDomain{{ID: "DN1"}},
+		CertificateAuthority: "lets_encrypt",
+	})
+	if err != ErrInvalidCertificateAuthority {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetTLSSubscription_validation(t *testing.T) {
@@ -195,3 +206,53 @@ func TestClient_DeleteTLSSubscription_validation(t *testing.T) {
 		t.Errorf("bad error: %s", err)
 	}
 }
+
+func TestClient_GetTLSSubscriptionAuthorizations(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tls/subscriptions/SUBSCRIPTION_ID" {
+			t.Errorf("bad path: %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("include") != "tls_authorizations" {
+			t.Errorf("bad include: %q", r.URL.Query().Get("include"))
+		}
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.Write([]byte(`{
+  "data": {
+    "id": "SUBSCRIPTION_ID",
+    "type": "tls_subscription",
+    "attributes": {"state": "pending"},
+    "relationships": {
+      "tls_authorizations": {"data": [{"id": "AUTH_ID", "type": "tls_authorization"}]}
+    }
+  },
+  "included": [{
+    "id": "AUTH_ID",
+    "type": "tls_authorization",
+    "attributes": {
+      "state": "pending",
+      "challenges": [{"type": "managed-dns", "record_type": "CNAME", "record_name": "_acme-challenge.example.com", "values": ["example.fastly-validations.com"]}]
+    }
+  }]
+}`))
+	})
+
+	auths, err := c.GetTLSSubscriptionAuthorizations(&GetTLSSubscriptionAuthorizationsInput{
+		ID: "SUBSCRIPTION_ID",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(auths) != 1 || len(auths[0].Challenges) != 1 {
+		t.Fatalf("bad authorizations: %#v", auths)
+	}
+	if got := auths[0].Challenges[0].RecordName; got != "_acme-challenge.example.com" {
+		t.Errorf("bad record name: %q", got)
+	}
+
+	_, err = testClient.GetTLSSubscriptionAuthorizations(&GetTLSSubscriptionAuthorizationsInput{})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+}
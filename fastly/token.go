@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"sort"
+	"strings"
 	"time"
 )
 
@@ -21,19 +22,36 @@ const (
 	GlobalReadScope TokenScope = "global:read"
 )
 
+// IsValid reports whether every space-delimited scope in s is a known token
+// scope.
+func (s TokenScope) IsValid() bool {
+	scopes := strings.Fields(string(s))
+	if len(scopes) == 0 {
+		return false
+	}
+	for _, scope := range scopes {
+		switch TokenScope(scope) {
+		case GlobalScope, PurgeSelectScope, PurgeAllScope, GlobalReadScope:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 // Token represents an API token which are used to authenticate requests to the
 // Fastly API.
 type Token struct {
-	ID          string     `mapstructure:"id"`
-	Name        string     `mapstructure:"name"`
-	UserID      string     `mapstructure:"user_id"`
-	Services    []string   `mapstructure:"services"`
-	AccessToken string     `mapstructure:"access_token"`
-	Scope       TokenScope `mapstructure:"scope"`
-	IP          string     `mapstructure:"ip"`
-	CreatedAt   *time.Time `mapstructure:"created_at"`
-	LastUsedAt  *time.Time `mapstructure:"last_used_at"`
-	ExpiresAt   *time.Time `mapstructure:"expires_at"`
+	ID          string     `mapstructure:"id" json:"id"`
+	Name        string     `mapstructure:"name" json:"name"`
+	UserID      string     `mapstructure:"user_id" json:"user_id"`
+	Services    []string   `mapstructure:"services" json:"services"`
+	AccessToken string     `mapstructure:"access_token" json:"access_token"`
+	Scope       TokenScope `mapstructure:"scope" json:"scope"`
+	IP          string     `mapstructure:"ip" json:"ip"`
+	CreatedAt   *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	LastUsedAt  *time.Time `mapstructure:"last_used_at" json:"last_used_at,omitempty"`
+	ExpiresAt   *time.Time `mapstructure:"expires_at" json:"expires_at,omitempty"`
 }
 
 // tokensByName is a sortable list of tokens.
@@ -105,7 +123,11 @@ func (c *Client) GetTokenSelf() (*Token, error) {
 	return t, nil
 }
 
-// CreateTokenInput is used as input to the Token function.
+// CreateTokenInput is used as input to the CreateToken function.
+//
+// Scope may hold several space-delimited scopes (e.g. "purge_select
+// global:read") and defaults to GlobalScope when empty. Services restricts the
+// token to the given service IDs.
 type CreateTokenInput struct {
 	Name      string     `url:"name,omitempty"`
 	Scope     TokenScope `url:"scope,omitempty"`
@@ -117,6 +139,10 @@ type CreateTokenInput struct {
 
 // CreateToken creates a new API token with the given information.
 func (c *Client) CreateToken(i *CreateTokenInput) (*Token, error) {
+	if i.Scope != "" && !i.Scope.IsValid() {
+		return nil, ErrInvalidTokenScope
+	}
+
 	_, err := c.PostForm("/sudo", i, nil)
 	if err != nil {
 		return nil, err
@@ -146,3 +146,28 @@ func TestClient_CreateAndBulkDeleteTokens(t *testing.T) {
 		t.Fatal(deleteErr)
 	}
 }
+
+func TestClient_CreateToken_validation(t *testing.T) {
+	var err error
+	_, err = testClient.CreateToken(&CreateTokenInput{
+		Name:  "my-test-token",
+		Scope: "global purge_everything",
+	})
+	if err != ErrInvalidTokenScope {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestTokenScope_IsValid(t *testing.T) {
+	for scope, want := range map[TokenScope]bool{
+		GlobalScope:                  true,
+		"purge_select global:read":   true,
+		"":                           false,
+		"global:write":               false,
+		"purge_all purge_everything": false,
+	} {
+		if got := scope.IsValid(); got != want {
+			t.Errorf("%q: got %t, want %t", scope, got, want)
+		}
+	}
+}
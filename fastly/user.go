@@ -9,20 +9,20 @@ import (
 
 // User represents a user of the Fastly API and web interface.
 type User struct {
-	ID                     string     `mapstructure:"id"`
-	Login                  string     `mapstructure:"login"`
-	Name                   string     `mapstructure:"name"`
-	Role                   string     `mapstructure:"role"`
-	CustomerID             string     `mapstructure:"customer_id"`
-	EmailHash              string     `mapstructure:"email_hash"`
-	LimitServices          bool       `mapstructure:"limit_services"`
-	Locked                 bool       `mapstructure:"locked"`
-	RequireNewPassword     bool       `mapstructure:"require_new_password"`
-	TwoFactorAuthEnabled   bool       `mapstructure:"two_factor_auth_enabled"`
-	TwoFactorSetupRequired bool       `mapstructure:"two_factor_setup_required"`
-	CreatedAt              *time.Time `mapstructure:"created_at"`
-	UpdatedAt              *time.Time `mapstructure:"updated_at"`
-	DeletedAt              *time.Time `mapstructure:"deleted_at"`
+	ID                     string     `mapstructure:"id" json:"id"`
+	Login                  string     `mapstructure:"login" json:"login"`
+	Name                   string     `mapstructure:"name" json:"name"`
+	Role                   string     `mapstructure:"role" json:"role"`
+	CustomerID             string     `mapstructure:"customer_id" json:"customer_id"`
+	EmailHash              string     `mapstructure:"email_hash" json:"email_hash"`
+	LimitServices          bool       `mapstructure:"limit_services" json:"limit_services"`
+	Locked                 bool       `mapstructure:"locked" json:"locked"`
+	RequireNewPassword     bool       `mapstructure:"require_new_password" json:"require_new_password"`
+	TwoFactorAuthEnabled   bool       `mapstructure:"two_factor_auth_enabled" json:"two_factor_auth_enabled"`
+	TwoFactorSetupRequired bool       `mapstructure:"two_factor_setup_required" json:"two_factor_setup_required"`
+	CreatedAt              *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt              *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt              *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // usersByLogin is a sortable list of users.
@@ -110,7 +110,7 @@ type CreateUserInput struct {
 	Role string `url:"role,omitempty"`
 }
 
-// CreateUser creates a new API token with the given information.
+// CreateUser creates a new user with the given information.
 func (c *Client) CreateUser(i *CreateUserInput) (*User, error) {
 	if i.Login == "" {
 		return nil, ErrMissingLogin
@@ -164,7 +164,7 @@ type DeleteUserInput struct {
 	ID string
 }
 
-// DeleteUser revokes a specific token by its ID.
+// DeleteUser deletes a specific user by its ID.
 func (c *Client) DeleteUser(i *DeleteUserInput) error {
 	if i.ID == "" {
 		return ErrMissingID
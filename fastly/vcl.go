@@ -9,15 +9,15 @@ import (
 
 // VCL represents a response about VCL from the Fastly API.
 type VCL struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name      string     `mapstructure:"name"`
-	Main      bool       `mapstructure:"main"`
-	Content   string     `mapstructure:"content"`
-	CreatedAt *time.Time `mapstructure:"created_at"`
-	UpdatedAt *time.Time `mapstructure:"updated_at"`
-	DeletedAt *time.Time `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name      string     `mapstructure:"name" json:"name"`
+	Main      bool       `mapstructure:"main" json:"main"`
+	Content   string     `mapstructure:"content" json:"content"`
+	CreatedAt *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // vclsByName is a sortable list of VCLs.
@@ -102,6 +102,45 @@ func (c *Client) GetVCL(i *GetVCLInput) (*VCL, error) {
 	return vcl, nil
 }
 
+// GetMainVCLInput is used as input to the GetMainVCL function.
+type GetMainVCLInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// ServiceVersion is the specific configuration version (required).
+	ServiceVersion int
+}
+
+// GetMainVCL returns the VCL marked as main on the version, as set with
+// ActivateVCL. It returns ErrNoMainVCL if none of the version's VCLs is main.
+func (c *Client) GetMainVCL(i *GetMainVCLInput) (*VCL, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	if i.ServiceVersion == 0 {
+		return nil, ErrMissingServiceVersion
+	}
+
+	vcls, err := c.ListVCLs(&ListVCLsInput{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if c.DryRun {
+		return nil, ErrDryRun
+	}
+
+	for _, v := range vcls {
+		if v.Main {
+			return v, nil
+		}
+	}
+	return nil, ErrNoMainVCL
+}
+
 // GetGeneratedVCLInput is used as input to the GetGeneratedVCL function.
 type GetGeneratedVCLInput struct {
 	// ServiceID is the ID of the service (required).
@@ -109,9 +148,14 @@ type GetGeneratedVCLInput struct {
 
 	// ServiceVersion is the specific configuration version (required).
 	ServiceVersion int
+
+	// Timeout, when positive, limits how long the request may take. See
+	// RequestOptions.Timeout.
+	Timeout time.Duration
 }
 
-// GetGeneratedVCL gets the VCL configuration with the given parameters.
+// GetGeneratedVCL gets the VCL that Fastly compiled for the given version,
+// including any custom VCL and snippets.
 func (c *Client) GetGeneratedVCL(i *GetGeneratedVCLInput) (*VCL, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
@@ -122,7 +166,7 @@ func (c *Client) GetGeneratedVCL(i *GetGeneratedVCLInput) (*VCL, error) {
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/generated_vcl", i.ServiceID, i.ServiceVersion)
-	resp, err := c.Get(path, nil)
+	resp, err := c.Get(path, &RequestOptions{Timeout: i.Timeout})
 	if err != nil {
 		return nil, err
 	}
@@ -157,6 +201,18 @@ func (c *Client) CreateVCL(i *CreateVCLInput) (*VCL, error) {
 		return nil, ErrMissingServiceVersion
 	}
 
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	if i.Content == "" {
+		return nil, ErrMissingContent
+	}
+
+	if err := c.checkServiceType(i.ServiceID, ServiceTypeVCL); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/vcl", i.ServiceID, i.ServiceVersion)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
@@ -185,7 +241,7 @@ type UpdateVCLInput struct {
 	Content *string `url:"content,omitempty"`
 }
 
-// UpdateVCL creates a new Fastly VCL.
+// UpdateVCL updates a specific VCL.
 func (c *Client) UpdateVCL(i *UpdateVCLInput) (*VCL, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
@@ -224,7 +280,7 @@ type ActivateVCLInput struct {
 	Name string
 }
 
-// ActivateVCL creates a new Fastly VCL.
+// ActivateVCL marks the given VCL as the main VCL of the version.
 func (c *Client) ActivateVCL(i *ActivateVCLInput) (*VCL, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
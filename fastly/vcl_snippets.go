@@ -45,6 +45,18 @@ const (
 // SnippetType is the type of VCL Snippet
 type SnippetType string
 
+// IsValid reports whether the type is a VCL subroutine supported by the Fastly
+// API.
+func (t SnippetType) IsValid() bool {
+	switch t {
+	case SnippetTypeInit, SnippetTypeRecv, SnippetTypeHash, SnippetTypeHit, SnippetTypeMiss, SnippetTypePass,
+		SnippetTypeFetch, SnippetTypeError, SnippetTypeDeliver, SnippetTypeLog, SnippetTypeNone:
+		return true
+	default:
+		return false
+	}
+}
+
 // Helper function to get a pointer to string
 func SnippetTypeToString(b string) *SnippetType {
 	p := SnippetType(b)
@@ -53,18 +65,18 @@ func SnippetTypeToString(b string) *SnippetType {
 
 // Snippet is the Fastly Snippet object
 type Snippet struct {
-	ServiceID      string `mapstructure:"service_id"`
-	ServiceVersion int    `mapstructure:"version"`
-
-	Name      string      `mapstructure:"name"`
-	ID        string      `mapstructure:"id"`
-	Priority  int         `mapstructure:"priority"`
-	Dynamic   int         `mapstructure:"dynamic"`
-	Content   string      `mapstructure:"content"`
-	Type      SnippetType `mapstructure:"type"`
-	CreatedAt *time.Time  `mapstructure:"created_at"`
-	UpdatedAt *time.Time  `mapstructure:"updated_at"`
-	DeletedAt *time.Time  `mapstructure:"deleted_at"`
+	ServiceID      string `mapstructure:"service_id" json:"service_id"`
+	ServiceVersion int    `mapstructure:"version" json:"version"`
+
+	Name      string      `mapstructure:"name" json:"name"`
+	ID        string      `mapstructure:"id" json:"id"`
+	Priority  int         `mapstructure:"priority" json:"priority"`
+	Dynamic   int         `mapstructure:"dynamic" json:"dynamic"`
+	Content   string      `mapstructure:"content" json:"content"`
+	Type      SnippetType `mapstructure:"type" json:"type"`
+	CreatedAt *time.Time  `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt *time.Time  `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt *time.Time  `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
 }
 
 // CreateSnippetInput is the input for CreateSnippet
@@ -115,6 +127,18 @@ func (c *Client) CreateSnippet(i *CreateSnippetInput) (*Snippet, error) {
 		return nil, ErrMissingType
 	}
 
+	if !i.Type.IsValid() {
+		return nil, ErrInvalidSnippetType
+	}
+
+	if i.Dynamic != 0 && i.Dynamic != 1 {
+		return nil, ErrInvalidSnippetDynamic
+	}
+
+	if err := c.checkServiceType(i.ServiceID, ServiceTypeVCL); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/snippet", i.ServiceID, i.ServiceVersion)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
@@ -165,6 +189,10 @@ func (c *Client) UpdateSnippet(i *UpdateSnippetInput) (*Snippet, error) {
 		return nil, ErrMissingName
 	}
 
+	if i.Type != nil && !i.Type.IsValid() {
+		return nil, ErrInvalidSnippetType
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/snippet/%s", i.ServiceID, i.ServiceVersion, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
@@ -180,12 +208,13 @@ func (c *Client) UpdateSnippet(i *UpdateSnippetInput) (*Snippet, error) {
 
 // DynamicSnippet is the object returned when updating or retrieving a Dynamic Snippet
 type DynamicSnippet struct {
-	ServiceID string `mapstructure:"service_id"`
-	ID        string `mapstructure:"snippet_id"`
+	ServiceID string `mapstructure:"service_id" json:"service_id"`
+	// ID is the ID of the snippet, which is stable across service versions.
+	ID string `mapstructure:"snippet_id" json:"snippet_id"`
 
-	Content   string     `mapstructure:"content"`
-	CreatedAt *time.Time `mapstructure:"created_at"`
-	UpdatedAt *time.Time `mapstructure:"updated_at"`
+	Content   string     `mapstructure:"content" json:"content"`
+	CreatedAt *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
 }
 
 // UpdateDynamicSnippetInput is the input for UpdateDynamicSnippet
@@ -196,11 +225,14 @@ type UpdateDynamicSnippetInput struct {
 	// ID is the ID of the Snippet to modify
 	ID string
 
-	// Content is the VCL code that specifies exactly what the snippet does.
+	// Content is the VCL code that specifies exactly what the snippet does (required).
 	Content *string `url:"content,omitempty"`
 }
 
-// UpdateDynamicSnippet replaces the content of a Dynamic Snippet
+// UpdateDynamicSnippet replaces the content of a Dynamic Snippet.
+//
+// Dynamic Snippets are not versioned: the new content is applied to the
+// service immediately, without cloning or activating a version.
 func (c *Client) UpdateDynamicSnippet(i *UpdateDynamicSnippetInput) (*DynamicSnippet, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
@@ -210,6 +242,10 @@ func (c *Client) UpdateDynamicSnippet(i *UpdateDynamicSnippetInput) (*DynamicSni
 		return nil, ErrMissingID
 	}
 
+	if i.Content == nil {
+		return nil, ErrMissingContent
+	}
+
 	path := fmt.Sprintf("/service/%s/snippet/%s", i.ServiceID, i.ID)
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
@@ -273,3 +273,68 @@ func Test_Snippets(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestClient_CreateSnippet_validation(t *testing.T) {
+	var err error
+	_, err = testClient.CreateSnippet(&CreateSnippetInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Content:        "#vcl",
+		Type:           SnippetType("bogus"),
+	})
+	if err != ErrInvalidSnippetType {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateSnippet(&CreateSnippetInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Content:        "#vcl",
+		Type:           SnippetTypeRecv,
+		Dynamic:        2,
+	})
+	if err != ErrInvalidSnippetDynamic {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_UpdateSnippet_validation(t *testing.T) {
+	var err error
+	_, err = testClient.UpdateSnippet(&UpdateSnippetInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "test",
+		Type:           SnippetTypeToString("bogus"),
+	})
+	if err != ErrInvalidSnippetType {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_UpdateDynamicSnippet_validation(t *testing.T) {
+	var err error
+	_, err = testClient.UpdateDynamicSnippet(&UpdateDynamicSnippetInput{
+		ServiceID: "",
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateDynamicSnippet(&UpdateDynamicSnippetInput{
+		ServiceID: "foo",
+		ID:        "",
+	})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateDynamicSnippet(&UpdateDynamicSnippetInput{
+		ServiceID: "foo",
+		ID:        "bar",
+	})
+	if err != ErrMissingContent {
+		t.Errorf("bad error: %s", err)
+	}
+}
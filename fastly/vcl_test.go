@@ -1,7 +1,9 @@
 package fastly
 
 import (
+	"net/http"
 	"testing"
+	"time"
 )
 
 func TestClient_VCLs(t *testing.T) {
@@ -186,6 +188,25 @@ func TestClient_CreateVCL_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateVCL(&CreateVCLInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateVCL(&CreateVCLInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "main.vcl",
+		Content:        "",
+	})
+	if err != ErrMissingContent {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetVCL_validation(t *testing.T) {
@@ -295,3 +316,97 @@ func TestClient_DeleteVCL_validation(t *testing.T) {
 		t.Errorf("bad error: %s", err)
 	}
 }
+
+func TestClient_CreateVCL_content(t *testing.T) {
+	t.Parallel()
+
+	content := "sub vcl_recv {\n\t#FASTLY recv\n\tif (req.url ~ \"^/a?b=c&d=%20+\") {\n\t\treturn(pass);\n\t}\n}\n"
+
+	var received string
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		received = r.PostFormValue("content")
+		w.Write([]byte(`{"name": "main.vcl"}`))
+	})
+
+	_, err := c.CreateVCL(&CreateVCLInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+		Name:           "main.vcl",
+		Content:        content,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if received != content {
+		t.Errorf("bad content: %q", received)
+	}
+}
+
+func TestClient_GetMainVCL(t *testing.T) {
+	t.Parallel()
+
+	vcls := `[{"name":"include","main":false},{"name":"main","main":true}]`
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/service/foo/version/1/vcl" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(vcls))
+	})
+
+	v, err := c.GetMainVCL(&GetMainVCLInput{ServiceID: "foo", ServiceVersion: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Name != "main" {
+		t.Errorf("bad VCL: %q", v.Name)
+	}
+
+	vcls = `[{"name":"include","main":false}]`
+	if _, err := c.GetMainVCL(&GetMainVCLInput{ServiceID: "foo", ServiceVersion: 1}); err != ErrNoMainVCL {
+		t.Errorf("bad error: %v", err)
+	}
+}
+
+func TestClient_GetMainVCL_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetMainVCL(&GetMainVCLInput{
+		ServiceVersion: 1,
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetMainVCL(&GetMainVCLInput{
+		ServiceID: "foo",
+	})
+	if err != ErrMissingServiceVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_GetGeneratedVCL_timeout(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		w.Write([]byte(`{"name":"generated","content":"sub vcl_recv {}"}`))
+	})
+
+	c.HTTPClient.Timeout = 5 * time.Second
+	if _, err := c.GetGeneratedVCL(&GetGeneratedVCLInput{
+		ServiceID:      "abc",
+		ServiceVersion: 1,
+		Timeout:        20 * time.Millisecond,
+	}); err == nil {
+		t.Error("expected the input timeout to apply")
+	}
+
+	c.HTTPClient.Timeout = 20 * time.Millisecond
+	if _, err := c.GetGeneratedVCL(&GetGeneratedVCLInput{
+		ServiceID:      "abc",
+		ServiceVersion: 1,
+		Timeout:        5 * time.Second,
+	}); err != nil {
+		t.Errorf("expected the input timeout to override the client's: %v", err)
+	}
+}
@@ -1,24 +1,33 @@
 package fastly
 
 import (
+	"context"
 	"fmt"
 	"sort"
+	"strings"
+	"sync"
 	"time"
 )
 
 // Version represents a distinct configuration version.
 type Version struct {
-	Number    int        `mapstructure:"number"`
-	Comment   string     `mapstructure:"comment"`
-	ServiceID string     `mapstructure:"service_id"`
-	Active    bool       `mapstructure:"active"`
-	Locked    bool       `mapstructure:"locked"`
-	Deployed  bool       `mapstructure:"deployed"`
-	Staging   bool       `mapstructure:"staging"`
-	Testing   bool       `mapstructure:"testing"`
-	CreatedAt *time.Time `mapstructure:"created_at"`
-	UpdatedAt *time.Time `mapstructure:"updated_at"`
-	DeletedAt *time.Time `mapstructure:"deleted_at"`
+	Number    int        `mapstructure:"number" json:"number"`
+	Comment   string     `mapstructure:"comment" json:"comment"`
+	ServiceID string     `mapstructure:"service_id" json:"service_id"`
+	Active    bool       `mapstructure:"active" json:"active"`
+	Locked    bool       `mapstructure:"locked" json:"locked"`
+	Deployed  bool       `mapstructure:"deployed" json:"deployed"`
+	Staging   bool       `mapstructure:"staging" json:"staging"`
+	Testing   bool       `mapstructure:"testing" json:"testing"`
+	CreatedAt *time.Time `mapstructure:"created_at" json:"created_at,omitempty"`
+	UpdatedAt *time.Time `mapstructure:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt *time.Time `mapstructure:"deleted_at" json:"deleted_at,omitempty"`
+
+	// Msg and Warnings carry the validation messages returned when the
+	// version is activated. They are empty, and omitted from JSON, for
+	// versions returned by any other call.
+	Msg      string   `mapstructure:"msg" json:"msg,omitempty"`
+	Warnings []string `mapstructure:"warnings" json:"warnings,omitempty"`
 }
 
 // versionsByNumber is a sortable list of versions. This is used by the version
@@ -38,14 +47,21 @@ type ListVersionsInput struct {
 	ServiceID string
 }
 
-// ListVersions returns the full list of all versions of the given service.
+// ListVersions returns the full list of all versions of the given service,
+// sorted by number. The API reports some state flags as 0 or 1 rather than
+// booleans; both forms decode into the boolean fields of Version.
 func (c *Client) ListVersions(i *ListVersionsInput) ([]*Version, error) {
+	return c.listVersions(context.Background(), i)
+}
+
+// listVersions is ListVersions with a context for the request.
+func (c *Client) listVersions(ctx context.Context, i *ListVersionsInput) ([]*Version, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
 	}
 
 	path := fmt.Sprintf("/service/%s/version", i.ServiceID)
-	resp, err := c.Get(path, nil)
+	resp, err := c.Get(path, &RequestOptions{Context: ctx})
 	if err != nil {
 		return nil, err
 	}
@@ -65,8 +81,9 @@ type LatestVersionInput struct {
 	ServiceID string
 }
 
-// LatestVersion fetches the latest version. If there are no versions, this
-// function will return nil (but not an error).
+// LatestVersion fetches the latest (highest-numbered) version, whether or not
+// it is active. If there are no versions, this function will return nil (but
+// not an error).
 func (c *Client) LatestVersion(i *LatestVersionInput) (*Version, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
@@ -76,6 +93,9 @@ func (c *Client) LatestVersion(i *LatestVersionInput) (*Version, error) {
 	if err != nil {
 		return nil, err
 	}
+	if c.DryRun {
+		return nil, ErrDryRun
+	}
 	if len(list) < 1 {
 		return nil, nil
 	}
@@ -84,6 +104,35 @@ func (c *Client) LatestVersion(i *LatestVersionInput) (*Version, error) {
 	return e, nil
 }
 
+// ActiveVersionInput is the input to the ActiveVersion function.
+type ActiveVersionInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+}
+
+// ActiveVersion fetches the currently active version of a service. It returns
+// ErrNoActiveVersion if no version is active.
+func (c *Client) ActiveVersion(i *ActiveVersionInput) (*Version, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	list, err := c.ListVersions(&ListVersionsInput{ServiceID: i.ServiceID})
+	if err != nil {
+		return nil, err
+	}
+	if c.DryRun {
+		return nil, ErrDryRun
+	}
+
+	for _, v := range list {
+		if v.Active {
+			return v, nil
+		}
+	}
+	return nil, ErrNoActiveVersion
+}
+
 // CreateVersionInput is the input to the CreateVersion function.
 type CreateVersionInput struct {
 	// ServiceID is the ID of the service (required).
@@ -119,7 +168,7 @@ type GetVersionInput struct {
 	// ServiceID is the ID of the service (required).
 	ServiceID string
 
-	// SrrviceVersion is the version number to fetch (required).
+	// ServiceVersion is the version number to fetch (required).
 	ServiceVersion int
 }
 
@@ -154,11 +203,15 @@ type UpdateVersionInput struct {
 	// ServiceVersion is the specific configuration version (required).
 	ServiceVersion int
 
-	// A personal freeform descriptive note.
+	// A personal freeform descriptive note (required). Use it to record why
+	// the version was created.
 	Comment *string `url:"comment,omitempty"`
 }
 
-// UpdateVersion updates the given version
+// UpdateVersion updates the comment of the given version. The comment is the
+// only writable field: Active, Locked and Deployed change through
+// ActivateVersion, DeactivateVersion and LockVersion, while Staging and
+// Testing are set by Fastly and are read-only.
 func (c *Client) UpdateVersion(i *UpdateVersionInput) (*Version, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
@@ -168,6 +221,10 @@ func (c *Client) UpdateVersion(i *UpdateVersionInput) (*Version, error) {
 		return nil, ErrMissingServiceVersion
 	}
 
+	if i.Comment == nil {
+		return nil, ErrMissingComment
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d", i.ServiceID, i.ServiceVersion)
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
@@ -188,10 +245,22 @@ type ActivateVersionInput struct {
 
 	// ServiceVersion is the specific configuration version (required).
 	ServiceVersion int
+
+	// Force activates the version even if its configuration has validation
+	// warnings. Forcing skips a safety check: the warnings may point at
+	// configuration that misbehaves once live, so review the returned
+	// Warnings.
+	Force bool
 }
 
-// ActivateVersion activates the given version.
+// ActivateVersion activates the given version. Any validation warnings are
+// returned in the Warnings field of the version.
 func (c *Client) ActivateVersion(i *ActivateVersionInput) (*Version, error) {
+	return c.activateVersion(context.Background(), i)
+}
+
+// activateVersion is ActivateVersion with a context for the request.
+func (c *Client) activateVersion(ctx context.Context, i *ActivateVersionInput) (*Version, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
 	}
@@ -200,8 +269,13 @@ func (c *Client) ActivateVersion(i *ActivateVersionInput) (*Version, error) {
 		return nil, ErrMissingServiceVersion
 	}
 
+	ro := &RequestOptions{Context: ctx}
+	if i.Force {
+		ro.Params = map[string]string{"force": "true"}
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/activate", i.ServiceID, i.ServiceVersion)
-	resp, err := c.Put(path, nil)
+	resp, err := c.Put(path, ro)
 	if err != nil {
 		return nil, err
 	}
@@ -258,6 +332,11 @@ type CloneVersionInput struct {
 // configuration version with all the same configuration options, but an
 // incremented number.
 func (c *Client) CloneVersion(i *CloneVersionInput) (*Version, error) {
+	return c.cloneVersion(context.Background(), i)
+}
+
+// cloneVersion is CloneVersion with a context for the request.
+func (c *Client) cloneVersion(ctx context.Context, i *CloneVersionInput) (*Version, error) {
 	if i.ServiceID == "" {
 		return nil, ErrMissingServiceID
 	}
@@ -267,7 +346,7 @@ func (c *Client) CloneVersion(i *CloneVersionInput) (*Version, error) {
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/clone", i.ServiceID, i.ServiceVersion)
-	resp, err := c.Put(path, nil)
+	resp, err := c.Put(path, &RequestOptions{Context: ctx})
 	if err != nil {
 		return nil, err
 	}
@@ -288,31 +367,85 @@ type ValidateVersionInput struct {
 	ServiceVersion int
 }
 
-// ValidateVersion validates if the given version is okay.
+// validateResp is the response body of the version validate endpoint.
+type validateResp struct {
+	Status   string   `mapstructure:"status" json:"status"`
+	Msg      string   `mapstructure:"msg" json:"msg"`
+	Errors   []string `mapstructure:"errors" json:"errors"`
+	Warnings []string `mapstructure:"warnings" json:"warnings"`
+}
+
+// ValidateVersion validates if the given version is okay. When the version is
+// invalid, the returned message describes why; if the API does not provide a
+// message, the individual validation errors are returned instead. Use
+// CheckVersion to receive the details as a *ValidationError.
 func (c *Client) ValidateVersion(i *ValidateVersionInput) (bool, string, error) {
-	var msg string
+	r, err := c.validateVersion(context.Background(), i)
+	if err != nil {
+		return false, "", err
+	}
+	return r.Status == "ok", r.message(), nil
+}
+
+// CheckVersion validates the given version like ValidateVersion, but returns
+// a *ValidationError describing the problems when the version is invalid, and
+// nil when it is valid.
+func (c *Client) CheckVersion(i *ValidateVersionInput) error {
+	return c.checkVersion(context.Background(), i)
+}
 
+// checkVersion is CheckVersion with a context for the request.
+func (c *Client) checkVersion(ctx context.Context, i *ValidateVersionInput) error {
+	r, err := c.validateVersion(ctx, i)
+	if err != nil {
+		return err
+	}
+	if r.Status == "ok" {
+		return nil
+	}
+	return &ValidationError{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Status:         r.Status,
+		Message:        r.message(),
+		Errors:         r.Errors,
+		Warnings:       r.Warnings,
+	}
+}
+
+// validateVersion fetches the validation result of the given version.
+func (c *Client) validateVersion(ctx context.Context, i *ValidateVersionInput) (*validateResp, error) {
 	if i.ServiceID == "" {
-		return false, msg, ErrMissingServiceID
+		return nil, ErrMissingServiceID
 	}
 
 	if i.ServiceVersion == 0 {
-		return false, msg, ErrMissingServiceVersion
+		return nil, ErrMissingServiceVersion
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/validate", i.ServiceID, i.ServiceVersion)
-	resp, err := c.Get(path, nil)
+	resp, err := c.Get(path, &RequestOptions{Context: ctx})
 	if err != nil {
-		return false, msg, err
+		return nil, err
 	}
 
-	var r *statusResp
+	var r *validateResp
 	if err := decodeBodyMap(resp.Body, &r); err != nil {
-		return false, msg, err
+		return nil, err
+	}
+	if r == nil {
+		r = &validateResp{}
 	}
+	return r, nil
+}
 
-	msg = r.Msg
-	return r.Ok(), msg, nil
+// message returns the validation message, falling back to the individual
+// errors when the API did not provide one.
+func (r *validateResp) message() string {
+	if r.Msg != "" {
+		return r.Msg
+	}
+	return strings.Join(r.Errors, "\n")
 }
 
 // LockVersionInput is the input to the LockVersion function.
@@ -346,3 +479,263 @@ func (c *Client) LockVersion(i *LockVersionInput) (*Version, error) {
 	}
 	return e, nil
 }
+
+// CloneSource selects which version WithNewVersion clones.
+type CloneSource int
+
+const (
+	// CloneFromActive clones the currently active version.
+	CloneFromActive CloneSource = iota
+
+	// CloneFromLatest clones the highest-numbered version, whether or not it
+	// is active.
+	CloneFromLatest
+)
+
+// WithNewVersionInput is the input to the WithNewVersion function.
+type WithNewVersionInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// CloneFrom selects the version to clone. Defaults to the active version.
+	CloneFrom CloneSource
+}
+
+// WithNewVersion clones a version of the service, calls f with the number of
+// the cloned version so that it can be modified, then validates and activates
+// it. The number of the cloned version is returned.
+//
+// If f returns an error or the cloned version fails validation, the cloned
+// version is left inactive and the error is returned; validation failures are
+// reported as a *ValidationError. The requests are made with ctx, so
+// cancelling it interrupts the request in flight, or stops before the next
+// step, and the cloned version is likewise left inactive.
+func (c *Client) WithNewVersion(ctx context.Context, i *WithNewVersionInput, f func(version int) error) (int, error) {
+	if i.ServiceID == "" {
+		return 0, ErrMissingServiceID
+	}
+
+	versions, err := c.listVersions(ctx, &ListVersionsInput{ServiceID: i.ServiceID})
+	if err != nil {
+		return 0, err
+	}
+	if c.DryRun {
+		return 0, ErrDryRun
+	}
+
+	var source int
+	switch i.CloneFrom {
+	case CloneFromLatest:
+		if len(versions) == 0 {
+			return 0, ErrNoVersions
+		}
+		source = versions[len(versions)-1].Number
+	default:
+		for _, v := range versions {
+			if v.Active {
+				source = v.Number
+			}
+		}
+		if source == 0 {
+			return 0, ErrNoActiveVersion
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	v, err := c.cloneVersion(ctx, &CloneVersionInput{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: source,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return v.Number, err
+	}
+
+	if err := f(v.Number); err != nil {
+		return v.Number, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return v.Number, err
+	}
+
+	if err := c.checkVersion(ctx, &ValidateVersionInput{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: v.Number,
+	}); err != nil {
+		return v.Number, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return v.Number, err
+	}
+
+	if _, err := c.activateVersion(ctx, &ActivateVersionInput{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: v.Number,
+	}); err != nil {
+		return v.Number, err
+	}
+
+	return v.Number, nil
+}
+
+// VersionSummaryConcurrency is the number of list requests GetVersionSummary
+// has in flight at once.
+const VersionSummaryConcurrency = 4
+
+// VersionSummary counts the objects configured on a version of a service.
+type VersionSummary struct {
+	ServiceID      string
+	ServiceVersion int
+
+	ACLs            int
+	Backends        int
+	CacheSettings   int
+	Conditions      int
+	Dictionaries    int
+	Directors       int
+	Domains         int
+	Gzips           int
+	Headers         int
+	HealthChecks    int
+	Pools           int
+	RequestSettings int
+	ResponseObjects int
+	Snippets        int
+	VCLs            int
+}
+
+// GetVersionSummaryInput is used as input to the GetVersionSummary function.
+type GetVersionSummaryInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+
+	// ServiceVersion is the specific configuration version (required).
+	ServiceVersion int
+}
+
+// GetVersionSummary counts the objects configured on a version by calling the
+// list endpoint for each kind of object, up to VersionSummaryConcurrency at a
+// time. If any of the calls fail, the first error is returned and the calls
+// that have not started yet are skipped.
+func (c *Client) GetVersionSummary(i *GetVersionSummaryInput) (*VersionSummary, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	if i.ServiceVersion == 0 {
+		return nil, ErrMissingServiceVersion
+	}
+
+	id, v := i.ServiceID, i.ServiceVersion
+	s := &VersionSummary{ServiceID: id, ServiceVersion: v}
+	counts := map[*int]func() (int, error){
+		&s.ACLs: func() (int, error) {
+			l, err := c.ListACLs(&ListACLsInput{ServiceID: id, ServiceVersion: v})
+			return len(l), err
+		},
+		&s.Backends: func() (int, error) {
+			l, err := c.ListBackends(&ListBackendsInput{ServiceID: id, ServiceVersion: v})
+			return len(l), err
+		},
+		&s.CacheSettings: func() (int, error) {
+			l, err := c.ListCacheSettings(&ListCacheSettingsInput{ServiceID: id, ServiceVersion: v})
+			return len(l), err
+		},
+		&s.Conditions: func() (int, error) {
+			l, err := c.ListConditions(&ListConditionsInput{ServiceID: id, ServiceVersion: v})
+			return len(l), err
+		},
+		&s.Dictionaries: func() (int, error) {
+			l, err := c.ListDictionaries(&ListDictionariesInput{ServiceID: id, ServiceVersion: v})
+			return len(l), err
+		},
+		&s.Directors: func() (int, error) {
+			l, err := c.ListDirectors(&ListDirectorsInput{ServiceID: id, ServiceVersion: v})
+			return len(l), err
+		},
+		&s.Domains: func() (int, error) {
+			l, err := c.ListDomains(&ListDomainsInput{ServiceID: id, ServiceVersion: v})
+			return len(l), err
+		},
+		&s.Gzips: func() (int, error) {
+			l, err := c.ListGzips(&ListGzipsInput{ServiceID: id, ServiceVersion: v})
+			return len(l), err
+		},
+		&s.Headers: func() (int, error) {
+			l, err := c.ListHeaders(&ListHeadersInput{ServiceID: id, ServiceVersion: v})
+			return len(l), err
+		},
+		&s.HealthChecks: func() (int, error) {
+			l, err := c.ListHealthChecks(&ListHealthChecksInput{ServiceID: id, ServiceVersion: v})
+			return len(l), err
+		},
+		&s.Pools: func() (int, error) {
+			l, err := c.ListPools(&ListPoolsInput{ServiceID: id, ServiceVersion: v})
+			return len(l), err
+		},
+		&s.RequestSettings: func() (int, error) {
+			l, err := c.ListRequestSettings(&ListRequestSettingsInput{ServiceID: id, ServiceVersion: v})
+			return len(l), err
+		},
+		&s.ResponseObjects: func() (int, error) {
+			l, err := c.ListResponseObjects(&ListResponseObjectsInput{ServiceID: id, ServiceVersion: v})
+			return len(l), err
+		},
+		&s.Snippets: func() (int, error) {
+			l, err := c.ListSnippets(&ListSnippetsInput{ServiceID: id, ServiceVersion: v})
+			return len(l), err
+		},
+		&s.VCLs: func() (int, error) {
+			l, err := c.ListVCLs(&ListVCLsInput{ServiceID: id, ServiceVersion: v})
+			return len(l), err
+		},
+	}
+
+	var (
+		mu       sync.Mutex
+		firstErr error
+		wg       sync.WaitGroup
+	)
+	sem := make(chan struct{}, VersionSummaryConcurrency)
+	for field, count := range counts {
+		mu.Lock()
+		failed := firstErr != nil
+		mu.Unlock()
+		if failed {
+			break
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(field *int, count func() (int, error)) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			n, err := count()
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			*field = n
+		}(field, count)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return s, nil
+}
@@ -1,8 +1,13 @@
 package fastly
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
 	"sort"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestClient_Versions(t *testing.T) {
@@ -184,6 +189,14 @@ func TestClient_UpdateVersion_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.UpdateVersion(&UpdateVersionInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+	})
+	if err != ErrMissingComment {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_ActivateVersion_validation(t *testing.T) {
@@ -258,6 +271,43 @@ func TestClient_ValidateVersion_validation(t *testing.T) {
 	}
 }
 
+func TestClient_ValidateVersion_errors(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status": "error", "msg": null, "errors": ["first problem", "second problem"], "warnings": []}`))
+	})
+
+	valid, msg, err := c.ValidateVersion(&ValidateVersionInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if valid {
+		t.Error("expected version to be invalid")
+	}
+	if msg != "first problem\nsecond problem" {
+		t.Errorf("bad msg: %q", msg)
+	}
+
+	err = c.CheckVersion(&ValidateVersionInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+	})
+	verr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("bad error: %v", err)
+	}
+	if verr.Status != "error" || len(verr.Errors) != 2 || verr.Message != msg {
+		t.Errorf("bad validation error: %+v", verr)
+	}
+	if got := verr.Error(); got != "version 1 of service foo is not valid: first problem\nsecond problem" {
+		t.Errorf("bad error string: %q", got)
+	}
+}
+
 func TestClient_LockVersion_validation(t *testing.T) {
 	var err error
 	_, err = testClient.LockVersion(&LockVersionInput{
@@ -275,3 +325,285 @@ func TestClient_LockVersion_validation(t *testing.T) {
 		t.Errorf("bad error: %s", err)
 	}
 }
+
+func TestClient_WithNewVersion(t *testing.T) {
+	t.Parallel()
+
+	var activated bool
+	valid := true
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /service/foo/version":
+			w.Write([]byte(`[{"number": 1, "active": true}, {"number": 2}]`))
+		case "PUT /service/foo/version/1/clone":
+			w.Write([]byte(`{"number": 3}`))
+		case "GET /service/foo/version/3/validate":
+			if valid {
+				w.Write([]byte(`{"status": "ok"}`))
+			} else {
+				w.Write([]byte(`{"status": "error", "msg": "bad config"}`))
+			}
+		case "PUT /service/foo/version/3/activate":
+			activated = true
+			w.Write([]byte(`{"number": 3, "active": true}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	var edited int
+	v, err := c.WithNewVersion(context.Background(), &WithNewVersionInput{ServiceID: "foo"}, func(version int) error {
+		edited = version
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 3 || edited != 3 {
+		t.Errorf("bad version: %d (edited %d)", v, edited)
+	}
+	if !activated {
+		t.Error("expected version to be activated")
+	}
+
+	activated = false
+	valid = false
+	if _, err := c.WithNewVersion(context.Background(), &WithNewVersionInput{ServiceID: "foo"}, func(int) error { return nil }); err == nil {
+		t.Error("expected validation error")
+	}
+	if activated {
+		t.Error("expected invalid version not to be activated")
+	}
+
+	valid = true
+	ctx, cancel := context.WithCancel(context.Background())
+	v, err = c.WithNewVersion(ctx, &WithNewVersionInput{ServiceID: "foo"}, func(int) error {
+		cancel()
+		return nil
+	})
+	if err != context.Canceled {
+		t.Errorf("bad error: %v", err)
+	}
+	if v != 3 || activated {
+		t.Errorf("expected version %d to be left inactive", v)
+	}
+}
+
+func TestClient_WithNewVersion_cancelInFlight(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /service/foo/version":
+			w.Write([]byte(`[{"number": 1, "active": true}]`))
+		case "PUT /service/foo/version/1/clone":
+			// Hold the clone until the client gives up on it.
+			<-r.Context().Done()
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := c.WithNewVersion(ctx, &WithNewVersionInput{ServiceID: "foo"}, func(int) error {
+		t.Error("expected the cloned version not to be edited")
+		return nil
+	})
+	if err == nil {
+		t.Fatal("expected the deadline to interrupt the clone")
+	}
+	if d := time.Since(start); d > 5*time.Second {
+		t.Errorf("clone was not interrupted, took %s", d)
+	}
+}
+
+func TestClient_WithNewVersion_noVersions(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	})
+
+	_, err := c.WithNewVersion(context.Background(), &WithNewVersionInput{
+		ServiceID: "foo",
+		CloneFrom: CloneFromLatest,
+	}, nil)
+	if err != ErrNoVersions {
+		t.Errorf("bad error: %v", err)
+	}
+
+	_, err = c.WithNewVersion(context.Background(), &WithNewVersionInput{ServiceID: "foo"}, nil)
+	if err != ErrNoActiveVersion {
+		t.Errorf("bad error: %v", err)
+	}
+}
+
+func TestClient_WithNewVersion_validation(t *testing.T) {
+	_, err := testClient.WithNewVersion(context.Background(), &WithNewVersionInput{
+		ServiceID: "",
+	}, nil)
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_ActiveVersion(t *testing.T) {
+	t.Parallel()
+
+	body := `[{"number": 3}, {"number": 1}, {"number": 2, "active": true}]`
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/service/foo/version" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(body))
+	})
+
+	v, err := c.ActiveVersion(&ActiveVersionInput{ServiceID: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Number != 2 {
+		t.Errorf("bad active version: %d", v.Number)
+	}
+
+	v, err = c.LatestVersion(&LatestVersionInput{ServiceID: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Number != 3 {
+		t.Errorf("bad latest version: %d", v.Number)
+	}
+
+	body = `[{"number": 1}]`
+	if _, err := c.ActiveVersion(&ActiveVersionInput{ServiceID: "foo"}); err != ErrNoActiveVersion {
+		t.Errorf("bad error: %v", err)
+	}
+
+	if _, err := c.ActiveVersion(&ActiveVersionInput{}); err != ErrMissingServiceID {
+		t.Errorf("bad error: %v", err)
+	}
+}
+
+func TestClient_ActivateVersion_force(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/service/foo/version/2/activate" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.URL.Query().Get("force"); got != "true" {
+			t.Errorf("bad force: %q", got)
+		}
+		w.Write([]byte(`{"number":2,"active":true,"warnings":["Backend has no health check"]}`))
+	})
+
+	v, err := c.ActivateVersion(&ActivateVersionInput{
+		ServiceID:      "foo",
+		ServiceVersion: 2,
+		Force:          true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !v.Active || len(v.Warnings) != 1 {
+		t.Errorf("bad version: %+v", v)
+	}
+}
+
+func TestClient_GetVersionSummary(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/service/foo/version/1/backend":
+			w.Write([]byte(`[{"name":"a"},{"name":"b"}]`))
+		case "/service/foo/version/1/domain":
+			w.Write([]byte(`[{"name":"example.com"}]`))
+		default:
+			w.Write([]byte(`[]`))
+		}
+	})
+
+	s, err := c.GetVersionSummary(&GetVersionSummaryInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Backends != 2 || s.Domains != 1 || s.Headers != 0 {
+		t.Errorf("bad summary: %+v", s)
+	}
+
+	c = newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/service/foo/version/1/header" {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"msg":"oops"}`))
+			return
+		}
+		w.Write([]byte(`[]`))
+	})
+	if _, err := c.GetVersionSummary(&GetVersionSummaryInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+	}); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestClient_GetVersionSummary_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetVersionSummary(&GetVersionSummaryInput{
+		ServiceVersion: 1,
+	})
+	if err != ErrMissingServiceID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetVersionSummary(&GetVersionSummaryInput{
+		ServiceID: "foo",
+	})
+	if err != ErrMissingServiceVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_ListVersions_flags(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[
+			{"number":2,"active":false,"locked":0,"deployed":false,"staging":0,"testing":0,"comment":"draft"},
+			{"number":1,"active":true,"locked":1,"deployed":1,"staging":false,"testing":"1","created_at":"2021-06-01T12:00:00Z"}
+		]`))
+	})
+
+	vs, err := c.ListVersions(&ListVersionsInput{ServiceID: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vs) != 2 || vs[0].Number != 1 || vs[1].Number != 2 {
+		t.Fatalf("bad versions: %v", vs)
+	}
+	v := vs[0]
+	if !v.Active || !v.Locked || !v.Deployed || v.Staging || !v.Testing || v.CreatedAt == nil {
+		t.Errorf("bad flags: %+v", v)
+	}
+	if v := vs[1]; v.Active || v.Locked || v.Deployed || v.Comment != "draft" {
+		t.Errorf("bad flags: %+v", v)
+	}
+}
+
+func TestVersion_marshalOmitsActivationFields(t *testing.T) {
+	b, err := json.Marshal(&Version{Number: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := string(b); strings.Contains(s, `"msg"`) || strings.Contains(s, `"warnings"`) {
+		t.Errorf("activation fields in marshalled version: %s", s)
+	}
+}
@@ -97,14 +97,7 @@ func (c *Client) ListWAFs(i *ListWAFsInput) (*WAFResponse, error) {
 		return nil, err
 	}
 
-	var buf bytes.Buffer
-	tee := io.TeeReader(resp.Body, &buf)
-
-	info, err := getResponseInfo(tee)
-	if err != nil {
-		return nil, err
-	}
-	data, err := jsonapi.UnmarshalManyPayload(bytes.NewReader(buf.Bytes()), wafType)
+	data, info, err := decodeJSONAPIList(resp.Body, wafType)
 	if err != nil {
 		return nil, err
 	}
@@ -126,9 +119,15 @@ func (c *Client) ListWAFs(i *ListWAFsInput) (*WAFResponse, error) {
 
 // CreateWAFInput is used as input to the CreateWAF function.
 type CreateWAFInput struct {
-	ID                string `jsonapi:"primary,waf_firewall"`
+	ID string `jsonapi:"primary,waf_firewall"`
+
+	// PrefetchCondition is the name of an existing prefetch condition on the
+	// service version.
 	PrefetchCondition string `jsonapi:"attr,prefetch_condition"`
-	Response          string `jsonapi:"attr,response"`
+
+	// Response is the name of an existing response object on the service
+	// version (required).
+	Response string `jsonapi:"attr,response"`
 
 	// ServiceID is the ID of the service (required).
 	ServiceID string `jsonapi:"attr,service_id"`
@@ -147,6 +146,14 @@ func (c *Client) CreateWAF(i *CreateWAFInput) (*WAF, error) {
 		return nil, ErrMissingServiceVersion
 	}
 
+	if i.Response == "" {
+		return nil, ErrMissingResponse
+	}
+
+	if err := c.validateWAFReferences(i.ServiceID, i.ServiceVersion, i.PrefetchCondition, i.Response); err != nil {
+		return nil, err
+	}
+
 	path := "/waf/firewalls"
 	resp, err := c.PostJSONAPI(path, i, nil)
 	if err != nil {
@@ -160,6 +167,64 @@ func (c *Client) CreateWAF(i *CreateWAFInput) (*WAF, error) {
 	return &waf, nil
 }
 
+// EnableWAFReferenceChecks makes CreateWAF check that the prefetch condition
+// and response object it references exist on the service version before
+// sending the request. A missing reference is returned as
+// ErrUnknownPrefetchCondition or ErrUnknownResponse, naming the field, rather
+// than the API's less helpful error. The check costs up to two extra
+// requests.
+func (c *Client) EnableWAFReferenceChecks() {
+	c.configLock.Lock()
+	c.wafReferenceChecks = true
+	c.configLock.Unlock()
+}
+
+// validateWAFReferences checks, when WAF reference checks are enabled, that
+// the prefetch condition (when set) and response object a WAF refers to exist
+// on the given service version. Checks are skipped in DryRun mode, where the
+// references cannot be looked up.
+func (c *Client) validateWAFReferences(serviceID string, serviceVersion int, condition, response string) error {
+	c.configLock.RLock()
+	enabled := c.wafReferenceChecks
+	c.configLock.RUnlock()
+	if !enabled || c.DryRun {
+		return nil
+	}
+
+	if condition != "" {
+		if _, err := c.GetCondition(&GetConditionInput{
+			ServiceID:      serviceID,
+			ServiceVersion: serviceVersion,
+			Name:           condition,
+		}); err != nil {
+			if isMissingReference(err) {
+				return ErrUnknownPrefetchCondition
+			}
+			return err
+		}
+	}
+
+	if _, err := c.GetResponseObject(&GetResponseObjectInput{
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion,
+		Name:           response,
+	}); err != nil {
+		if isMissingReference(err) {
+			return ErrUnknownResponse
+		}
+		return err
+	}
+	return nil
+}
+
+// isMissingReference reports whether err is the API's answer to a lookup of a
+// named object that does not exist on a version, which depending on the
+// endpoint is either a 404 or a 400.
+func isMissingReference(err error) bool {
+	herr, ok := err.(*HTTPError)
+	return ok && (herr.IsNotFound() || herr.IsBadRequest())
+}
+
 // GetWAFInput is used as input to the GetWAF function.
 type GetWAFInput struct {
 	// ServiceID is the ID of the service (required).
@@ -295,6 +360,24 @@ type metaInfo struct {
 	TotalPages  int `json:"total_pages,omitempty"`
 }
 
+// decodeJSONAPIList decodes a paginated JSON:API response body, returning the
+// records (as pointers of type t) together with the links and meta envelope.
+func decodeJSONAPIList(body io.Reader, t reflect.Type) ([]interface{}, infoResponse, error) {
+	var buf bytes.Buffer
+	tee := io.TeeReader(body, &buf)
+
+	info, err := getResponseInfo(tee)
+	if err != nil {
+		return nil, infoResponse{}, err
+	}
+
+	data, err := jsonapi.UnmarshalManyPayload(bytes.NewReader(buf.Bytes()), t)
+	if err != nil {
+		return nil, infoResponse{}, err
+	}
+	return data, info, nil
+}
+
 // getResponseInfo parses a response to get the pagination and metadata info.
 func getResponseInfo(body io.Reader) (infoResponse, error) {
 
@@ -1,9 +1,8 @@
 package fastly
 
 import (
-	"bytes"
+	"context"
 	"fmt"
-	"io"
 	"reflect"
 	"strconv"
 	"time"
@@ -15,6 +14,26 @@ import (
 // decoding into.
 var WAFActiveRuleType = reflect.TypeOf(new(WAFActiveRule))
 
+const (
+	// WAFActiveRuleStatusLog logs requests matching the rule.
+	WAFActiveRuleStatusLog = "log"
+	// WAFActiveRuleStatusBlock blocks requests matching the rule.
+	WAFActiveRuleStatusBlock = "block"
+	// WAFActiveRuleStatusScore adds to the anomaly score of requests matching
+	// the rule.
+	WAFActiveRuleStatusScore = "score"
+)
+
+// validWAFActiveRuleStatus reports whether s is a status the API accepts for
+// an active rule.
+func validWAFActiveRuleStatus(s string) bool {
+	switch s {
+	case WAFActiveRuleStatusLog, WAFActiveRuleStatusBlock, WAFActiveRuleStatusScore:
+		return true
+	}
+	return false
+}
+
 // WAFActiveRule is the information about a WAF active rule object.
 type WAFActiveRule struct {
 	ID             string     `jsonapi:"primary,waf_active_rule,omitempty"`
@@ -99,15 +118,7 @@ func (c *Client) ListWAFActiveRules(i *ListWAFActiveRulesInput) (*WAFActiveRuleR
 		return nil, err
 	}
 
-	var buf bytes.Buffer
-	tee := io.TeeReader(resp.Body, &buf)
-
-	info, err := getResponseInfo(tee)
-	if err != nil {
-		return nil, err
-	}
-
-	data, err := jsonapi.UnmarshalManyPayload(bytes.NewReader(buf.Bytes()), WAFActiveRuleType)
+	data, info, err := decodeJSONAPIList(resp.Body, WAFActiveRuleType)
 	if err != nil {
 		return nil, err
 	}
@@ -154,30 +165,26 @@ func (c *Client) ListAllWAFActiveRules(i *ListAllWAFActiveRulesInput) (*WAFActiv
 		return nil, ErrMissingWAFVersionNumber
 	}
 
-	currentPage := 1
-	result := &WAFActiveRuleResponse{Items: []*WAFActiveRule{}}
-	for {
-		r, err := c.ListWAFActiveRules(&ListWAFActiveRulesInput{
-			WAFID:            i.WAFID,
-			WAFVersionNumber: i.WAFVersionNumber,
-			PageNumber:       currentPage,
-			PageSize:         WAFPaginationPageSize,
-			Include:          i.Include,
-			FilterStatus:     i.FilterStatus,
-			FilterModSedID:   i.FilterModSedID,
-			FilterMessage:    i.FilterMessage,
-		})
-		if err != nil {
-			return r, err
-		}
-
-		currentPage++
-		result.Items = append(result.Items, r.Items...)
+	params := (&ListWAFActiveRulesInput{
+		PageNumber:     1,
+		PageSize:       WAFPaginationPageSize,
+		Include:        i.Include,
+		FilterStatus:   i.FilterStatus,
+		FilterModSedID: i.FilterModSedID,
+		FilterMessage:  i.FilterMessage,
+	}).formatFilters()
 
-		if r.Info.Links.Next == "" || len(r.Items) == 0 {
-			return result, nil
+	path := fmt.Sprintf("/waf/firewalls/%s/versions/%d/active-rules", i.WAFID, i.WAFVersionNumber)
+	p := c.newJSONAPIPaginator(context.Background(), path, params, WAFActiveRuleType)
+	result := &WAFActiveRuleResponse{Items: []*WAFActiveRule{}}
+	for p.hasNext() {
+		var items []*WAFActiveRule
+		if err := p.getNextInto(&items); err != nil {
+			return nil, err
 		}
+		result.Items = append(result.Items, items...)
 	}
+	return result, nil
 }
 
 // CreateWAFActiveRulesInput used as input for adding rules to a WAF.
@@ -205,6 +212,15 @@ func (c *Client) CreateWAFActiveRules(i *CreateWAFActiveRulesInput) ([]*WAFActiv
 		return nil, ErrMissingWAFActiveRule
 	}
 
+	for _, r := range i.Rules {
+		if r.ModSecID == 0 {
+			return nil, ErrMissingModSecID
+		}
+		if !validWAFActiveRuleStatus(r.Status) {
+			return nil, ErrInvalidWAFActiveRuleStatus
+		}
+	}
+
 	path := fmt.Sprintf("/waf/firewalls/%s/versions/%d/active-rules", i.WAFID, i.WAFVersionNumber)
 	resp, err := c.PostJSONAPIBulk(path, i.Rules, nil)
 	if err != nil {
@@ -228,6 +244,90 @@ func (c *Client) CreateWAFActiveRules(i *CreateWAFActiveRulesInput) ([]*WAFActiv
 	return wafRules, nil
 }
 
+// UpdateWAFActiveRuleInput is used as input to the UpdateWAFActiveRule
+// function.
+type UpdateWAFActiveRuleInput struct {
+	// The Web Application Firewall's ID.
+	WAFID string
+	// The Web Application Firewall's version number.
+	WAFVersionNumber int
+	// The ModSecurity rule ID of the active rule to update.
+	ModSecID int
+	// The new status of the rule (log, block or score). Empty keeps the
+	// current status.
+	Status string
+	// The rule revision to use. Zero keeps the current revision.
+	Revision int
+}
+
+// UpdateWAFActiveRule changes the status or revision of a single active rule.
+func (c *Client) UpdateWAFActiveRule(i *UpdateWAFActiveRuleInput) (*WAFActiveRule, error) {
+	if i.WAFID == "" {
+		return nil, ErrMissingWAFID
+	}
+
+	if i.WAFVersionNumber == 0 {
+		return nil, ErrMissingWAFVersionNumber
+	}
+
+	if i.ModSecID == 0 {
+		return nil, ErrMissingModSecID
+	}
+
+	if i.Status == "" && i.Revision == 0 {
+		return nil, ErrMissingOptionalStatusRevision
+	}
+
+	if i.Status != "" && !validWAFActiveRuleStatus(i.Status) {
+		return nil, ErrInvalidWAFActiveRuleStatus
+	}
+
+	path := fmt.Sprintf("/waf/firewalls/%s/versions/%d/active-rules/%d", i.WAFID, i.WAFVersionNumber, i.ModSecID)
+	resp, err := c.PatchJSONAPI(path, &WAFActiveRule{
+		Status:   i.Status,
+		Revision: i.Revision,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rule WAFActiveRule
+	if err := jsonapi.UnmarshalPayload(resp.Body, &rule); err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// DeleteWAFActiveRuleInput is used as input to the DeleteWAFActiveRule
+// function.
+type DeleteWAFActiveRuleInput struct {
+	// The Web Application Firewall's ID.
+	WAFID string
+	// The Web Application Firewall's version number.
+	WAFVersionNumber int
+	// The ModSecurity rule ID of the active rule to remove.
+	ModSecID int
+}
+
+// DeleteWAFActiveRule removes a single rule from a particular WAF.
+func (c *Client) DeleteWAFActiveRule(i *DeleteWAFActiveRuleInput) error {
+	if i.WAFID == "" {
+		return ErrMissingWAFID
+	}
+
+	if i.WAFVersionNumber == 0 {
+		return ErrMissingWAFVersionNumber
+	}
+
+	if i.ModSecID == 0 {
+		return ErrMissingModSecID
+	}
+
+	path := fmt.Sprintf("/waf/firewalls/%s/versions/%d/active-rules/%d", i.WAFID, i.WAFVersionNumber, i.ModSecID)
+	_, err := c.Delete(path, nil)
+	return err
+}
+
 // BatchModificationWAFActiveRulesInput is used for active rules batch modifications.
 type BatchModificationWAFActiveRulesInput struct {
 	// The Web Application Firewall's ID.
@@ -1,6 +1,8 @@
 package fastly
 
 import (
+	"encoding/json"
+	"net/http"
 	"testing"
 )
 
@@ -207,6 +209,15 @@ func TestClient_CreateWAFActiveRules_validation(t *testing.T) {
 	if err != ErrMissingWAFActiveRule {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateWAFActiveRules(&CreateWAFActiveRulesInput{
+		WAFID:            "1",
+		WAFVersionNumber: 1,
+		Rules:            []*WAFActiveRule{{ModSecID: 1010010, Status: "allow"}},
+	})
+	if err != ErrInvalidWAFActiveRuleStatus {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_BatchModificationWAFActiveRules_validation(t *testing.T) {
@@ -277,3 +288,84 @@ func buildWAFRules(status string) []*WAFActiveRule {
 		},
 	}
 }
+
+func TestClient_UpdateWAFActiveRule_validation(t *testing.T) {
+	var err error
+	_, err = testClient.UpdateWAFActiveRule(&UpdateWAFActiveRuleInput{
+		WAFID:            "1",
+		WAFVersionNumber: 1,
+	})
+	if err != ErrMissingModSecID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateWAFActiveRule(&UpdateWAFActiveRuleInput{
+		WAFID:            "1",
+		WAFVersionNumber: 1,
+		ModSecID:         1010010,
+		Status:           "disabled",
+	})
+	if err != ErrInvalidWAFActiveRuleStatus {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateWAFActiveRule(&UpdateWAFActiveRuleInput{
+		WAFID:            "1",
+		WAFVersionNumber: 1,
+		ModSecID:         1010010,
+	})
+	if err != ErrMissingOptionalStatusRevision {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_UpdateWAFActiveRule_revisionOnly(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/waf/firewalls/1/versions/1/active-rules/1010010" {
+			t.Errorf("bad request: %s %s", r.Method, r.URL.Path)
+		}
+		var body struct {
+			Data struct {
+				Attributes map[string]interface{} `json:"attributes"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if _, ok := body.Data.Attributes["status"]; ok {
+			t.Errorf("status should not be sent: %v", body.Data.Attributes)
+		}
+		if got := body.Data.Attributes["revision"]; got != float64(2) {
+			t.Errorf("bad revision: %v", got)
+		}
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.Write([]byte(`{"data":{"id":"1010010","type":"waf_active_rule","attributes":{"status":"log","revision":2}}}`))
+	})
+
+	rule, err := c.UpdateWAFActiveRule(&UpdateWAFActiveRuleInput{
+		WAFID:            "1",
+		WAFVersionNumber: 1,
+		ModSecID:         1010010,
+		Revision:         2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rule.Revision != 2 || rule.Status != "log" {
+		t.Errorf("bad rule: %+v", rule)
+	}
+}
+
+func TestClient_DeleteWAFActiveRule_validation(t *testing.T) {
+	err := testClient.DeleteWAFActiveRule(&DeleteWAFActiveRuleInput{
+		WAFID:            "1",
+		WAFVersionNumber: 1,
+	})
+	if err != ErrMissingModSecID {
+		t.Errorf("bad error: %s", err)
+	}
+}
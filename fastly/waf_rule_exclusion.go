@@ -1,9 +1,8 @@
 package fastly
 
 import (
-	"bytes"
+	"context"
 	"fmt"
-	"io"
 	"reflect"
 	"strconv"
 	"strings"
@@ -161,15 +160,7 @@ func (c *Client) ListWAFRuleExclusions(i *ListWAFRuleExclusionsInput) (*WAFRuleE
 		return nil, err
 	}
 
-	var buf bytes.Buffer
-	tee := io.TeeReader(resp.Body, &buf)
-
-	info, err := getResponseInfo(tee)
-	if err != nil {
-		return nil, err
-	}
-
-	data, err := jsonapi.UnmarshalManyPayload(bytes.NewReader(buf.Bytes()), WAFRuleExclusionType)
+	data, info, err := decodeJSONAPIList(resp.Body, WAFRuleExclusionType)
 	if err != nil {
 		return nil, err
 	}
@@ -200,31 +191,27 @@ func (c *Client) ListAllWAFRuleExclusions(i *ListAllWAFRuleExclusionsInput) (*WA
 		return nil, ErrMissingWAFVersionNumber
 	}
 
-	currentPage := 1
-	pageSize := WAFPaginationPageSize
-	result := &WAFRuleExclusionResponse{Items: []*WAFRuleExclusion{}}
-	for {
-		r, err := c.ListWAFRuleExclusions(&ListWAFRuleExclusionsInput{
-			WAFID:               i.WAFID,
-			WAFVersionNumber:    i.WAFVersionNumber,
-			PageNumber:          &currentPage,
-			PageSize:            &pageSize,
-			Include:             i.Include,
-			FilterName:          i.FilterName,
-			FilterModSedID:      i.FilterModSedID,
-			FilterExclusionType: i.FilterExclusionType,
-		})
-		if err != nil {
-			return r, err
-		}
-
-		currentPage++
-		result.Items = append(result.Items, r.Items...)
+	pageNumber, pageSize := 1, WAFPaginationPageSize
+	params := (&ListWAFRuleExclusionsInput{
+		PageNumber:          &pageNumber,
+		PageSize:            &pageSize,
+		Include:             i.Include,
+		FilterName:          i.FilterName,
+		FilterModSedID:      i.FilterModSedID,
+		FilterExclusionType: i.FilterExclusionType,
+	}).formatFilters()
 
-		if r.Info.Links.Next == "" || len(r.Items) == 0 {
-			return result, nil
+	path := fmt.Sprintf("/waf/firewalls/%s/versions/%d/exclusions", i.WAFID, i.WAFVersionNumber)
+	p := c.newJSONAPIPaginator(context.Background(), path, params, WAFRuleExclusionType)
+	result := &WAFRuleExclusionResponse{Items: []*WAFRuleExclusion{}}
+	for p.hasNext() {
+		var items []*WAFRuleExclusion
+		if err := p.getNextInto(&items); err != nil {
+			return nil, err
 		}
+		result.Items = append(result.Items, items...)
 	}
+	return result, nil
 }
 
 // CreateWAFRuleExclusion used to create a particular WAF rule exclusion.
@@ -1,14 +1,11 @@
 package fastly
 
 import (
-	"bytes"
+	"context"
 	"fmt"
-	"io"
 	"reflect"
 	"strconv"
 	"strings"
-
-	"github.com/google/jsonapi"
 )
 
 // WAFRuleType is used for reflection because JSONAPI wants to know what it's
@@ -113,15 +110,7 @@ func (c *Client) ListWAFRules(i *ListWAFRulesInput) (*WAFRuleResponse, error) {
 		return nil, err
 	}
 
-	var buf bytes.Buffer
-	tee := io.TeeReader(resp.Body, &buf)
-
-	info, err := getResponseInfo(tee)
-	if err != nil {
-		return nil, err
-	}
-
-	data, err := jsonapi.UnmarshalManyPayload(bytes.NewReader(buf.Bytes()), WAFRuleType)
+	data, info, err := decodeJSONAPIList(resp.Body, WAFRuleType)
 	if err != nil {
 		return nil, err
 	}
@@ -158,27 +147,24 @@ type ListAllWAFRulesInput struct {
 // all existing pages to ensure all WAF rules are returned at once.
 func (c *Client) ListAllWAFRules(i *ListAllWAFRulesInput) (*WAFRuleResponse, error) {
 
-	currentPage := 1
+	params := (&ListWAFRulesInput{
+		FilterTagNames:   i.FilterTagNames,
+		FilterPublishers: i.FilterPublishers,
+		FilterModSecIDs:  i.FilterModSecIDs,
+		ExcludeMocSecIDs: i.ExcludeMocSecIDs,
+		Include:          i.Include,
+		PageNumber:       1,
+		PageSize:         WAFPaginationPageSize,
+	}).formatFilters()
+
+	p := c.newJSONAPIPaginator(context.Background(), "/waf/rules", params, WAFRuleType)
 	result := &WAFRuleResponse{Items: []*WAFRule{}}
-	for {
-		r, err := c.ListWAFRules(&ListWAFRulesInput{
-			FilterTagNames:   i.FilterTagNames,
-			FilterPublishers: i.FilterPublishers,
-			FilterModSecIDs:  i.FilterModSecIDs,
-			ExcludeMocSecIDs: i.ExcludeMocSecIDs,
-			Include:          i.Include,
-			PageNumber:       currentPage,
-			PageSize:         WAFPaginationPageSize,
-		})
-		if err != nil {
-			return r, err
-		}
-
-		currentPage++
-		result.Items = append(result.Items, r.Items...)
-
-		if r.Info.Links.Next == "" || len(r.Items) == 0 {
-			return result, nil
+	for p.hasNext() {
+		var items []*WAFRule
+		if err := p.getNextInto(&items); err != nil {
+			return nil, err
 		}
+		result.Items = append(result.Items, items...)
 	}
+	return result, nil
 }
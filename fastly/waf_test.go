@@ -1,6 +1,7 @@
 package fastly
 
 import (
+	"net/http"
 	"reflect"
 	"testing"
 )
@@ -167,6 +168,14 @@ func TestClient_CreateWAF_validation(t *testing.T) {
 	if err != ErrMissingServiceVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateWAF(&CreateWAFInput{
+		ServiceID:      "foo",
+		ServiceVersion: 1,
+	})
+	if err != ErrMissingResponse {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetWAF_validation(t *testing.T) {
@@ -302,3 +311,53 @@ func TestClient_listWAFs_formatFilters(t *testing.T) {
 		}
 	}
 }
+
+func TestClient_CreateWAF_unknownResponse(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/service/foo/version/1/condition/WAF_Prefetch":
+			w.Write([]byte(`{"name": "WAF_Prefetch", "type": "PREFETCH"}`))
+		case "/service/foo/version/1/response_object/WAF_Response":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"msg": "Record not found"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+	c.EnableWAFReferenceChecks()
+
+	_, err := c.CreateWAF(&CreateWAFInput{
+		ServiceID:         "foo",
+		ServiceVersion:    1,
+		PrefetchCondition: "WAF_Prefetch",
+		Response:          "WAF_Response",
+	})
+	if err != ErrUnknownResponse {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_CreateWAF_unknownPrefetchCondition(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/service/foo/version/1/condition/WAF_Prefetch" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"msg": "Bad request", "detail": "Couldn't find condition 'WAF_Prefetch'"}`))
+	})
+	c.EnableWAFReferenceChecks()
+
+	_, err := c.CreateWAF(&CreateWAFInput{
+		ServiceID:         "foo",
+		ServiceVersion:    1,
+		PrefetchCondition: "WAF_Prefetch",
+		Response:          "WAF_Response",
+	})
+	if err != ErrUnknownPrefetchCondition {
+		t.Errorf("bad error: %s", err)
+	}
+}
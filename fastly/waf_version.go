@@ -1,9 +1,8 @@
 package fastly
 
 import (
-	"bytes"
+	"context"
 	"fmt"
-	"io"
 	"reflect"
 	"strconv"
 	"time"
@@ -142,15 +141,7 @@ func (c *Client) ListWAFVersions(i *ListWAFVersionsInput) (*WAFVersionResponse,
 		return nil, err
 	}
 
-	var buf bytes.Buffer
-	tee := io.TeeReader(resp.Body, &buf)
-
-	info, err := getResponseInfo(tee)
-	if err != nil {
-		return nil, err
-	}
-
-	data, err := jsonapi.UnmarshalManyPayload(bytes.NewReader(buf.Bytes()), WAFVersionType)
+	data, info, err := decodeJSONAPIList(resp.Body, WAFVersionType)
 	if err != nil {
 		return nil, err
 	}
@@ -185,26 +176,54 @@ func (c *Client) ListAllWAFVersions(i *ListAllWAFVersionsInput) (*WAFVersionResp
 		return nil, ErrMissingWAFID
 	}
 
-	currentPage := 1
+	params := (&ListWAFVersionsInput{
+		Include:    i.Include,
+		PageNumber: 1,
+		PageSize:   WAFPaginationPageSize,
+	}).formatFilters()
+
+	path := fmt.Sprintf("/waf/firewalls/%s/versions", i.WAFID)
+	p := c.newJSONAPIPaginator(context.Background(), path, params, WAFVersionType)
 	result := &WAFVersionResponse{Items: []*WAFVersion{}}
-	for {
-		r, err := c.ListWAFVersions(&ListWAFVersionsInput{
-			WAFID:      i.WAFID,
-			Include:    i.Include,
-			PageNumber: currentPage,
-			PageSize:   WAFPaginationPageSize,
-		})
-		if err != nil {
-			return r, err
+	for p.hasNext() {
+		var items []*WAFVersion
+		if err := p.getNextInto(&items); err != nil {
+			return nil, err
 		}
+		result.Items = append(result.Items, items...)
+	}
+	return result, nil
+}
+
+// ActiveWAFVersionInput used as input for ActiveWAFVersion function.
+type ActiveWAFVersionInput struct {
+	// The Web Application Firewall's ID.
+	WAFID string
+}
 
-		currentPage++
-		result.Items = append(result.Items, r.Items...)
+// ActiveWAFVersion fetches the currently active version of a WAF, mirroring
+// ActiveVersion for services. The usual workflow is to clone the active
+// version, edit the clone, and deploy it with DeployWAFVersion. It returns
+// ErrNoActiveWAFVersion if no version is active.
+func (c *Client) ActiveWAFVersion(i *ActiveWAFVersionInput) (*WAFVersion, error) {
+	if i.WAFID == "" {
+		return nil, ErrMissingWAFID
+	}
 
-		if r.Info.Links.Next == "" || len(r.Items) == 0 {
-			return result, nil
+	r, err := c.ListAllWAFVersions(&ListAllWAFVersionsInput{WAFID: i.WAFID})
+	if err != nil {
+		return nil, err
+	}
+	if c.DryRun {
+		return nil, ErrDryRun
+	}
+
+	for _, v := range r.Items {
+		if v.Active {
+			return v, nil
 		}
 	}
+	return nil, ErrNoActiveWAFVersion
 }
 
 // GetWAFVersionInput used as input for GetWAFVersion function.
@@ -408,6 +427,75 @@ func (c *Client) DeployWAFVersion(i *DeployWAFVersionInput) error {
 	return nil
 }
 
+// DefaultWAFDeploymentPollInterval is the interval at which
+// DeployWAFVersionAndWait polls the deployment status when no interval is
+// given.
+const DefaultWAFDeploymentPollInterval = 5 * time.Second
+
+// DeployWAFVersionAndWaitInput used as input for deploying a WAF version and
+// waiting for the deployment to finish.
+type DeployWAFVersionAndWaitInput struct {
+	// The Web Application Firewall's ID.
+	WAFID string
+	// The Web Application Firewall's version number.
+	WAFVersionNumber int
+	// PollInterval is how often the deployment status is checked. It defaults
+	// to DefaultWAFDeploymentPollInterval.
+	PollInterval time.Duration
+	// Progress, if set, is called with the WAF version after each poll.
+	Progress func(*WAFVersion)
+}
+
+// DeployWAFVersionAndWait deploys a specific WAF version and polls it until
+// its last_deployment_status is completed or failed. A failed deployment
+// returns the version together with an error wrapping
+// ErrWAFDeploymentFailed. Cancelling ctx stops the polling, not the
+// deployment itself.
+func (c *Client) DeployWAFVersionAndWait(ctx context.Context, i *DeployWAFVersionAndWaitInput) (*WAFVersion, error) {
+	if err := c.DeployWAFVersion(&DeployWAFVersionInput{
+		WAFID:            i.WAFID,
+		WAFVersionNumber: i.WAFVersionNumber,
+	}); err != nil {
+		return nil, err
+	}
+	if c.DryRun {
+		return nil, ErrDryRun
+	}
+
+	interval := i.PollInterval
+	if interval <= 0 {
+		interval = DefaultWAFDeploymentPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		v, err := c.GetWAFVersion(&GetWAFVersionInput{
+			WAFID:            i.WAFID,
+			WAFVersionNumber: i.WAFVersionNumber,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if i.Progress != nil {
+			i.Progress(v)
+		}
+
+		switch v.LastDeploymentStatus {
+		case WAFVersionDeploymentStatusCompleted:
+			return v, nil
+		case WAFVersionDeploymentStatusFailed:
+			return v, fmt.Errorf("%w: %s", ErrWAFDeploymentFailed, v.Error)
+		}
+
+		select {
+		case <-ctx.Done():
+			return v, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
 // CreateEmptyWAFVersionInput used as input for creating an empty WAF version.
 type CreateEmptyWAFVersionInput struct {
 	// The Web Application Firewall's ID.
@@ -1,7 +1,10 @@
 package fastly
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"reflect"
 	"testing"
 	"time"
@@ -559,3 +562,72 @@ func intToPtr(i int) *int {
 func boolToPtr(i bool) *bool {
 	return &i
 }
+
+func TestClient_DeployWAFVersionAndWait(t *testing.T) {
+	t.Parallel()
+
+	var polls int
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/waf/firewalls/WAF_ID/versions/2/activate":
+			w.WriteHeader(http.StatusAccepted)
+		case r.Method == http.MethodGet && r.URL.Path == "/waf/firewalls/WAF_ID/versions/2":
+			polls++
+			status := WAFVersionDeploymentStatusInProgress
+			if polls == 2 {
+				status = WAFVersionDeploymentStatusFailed
+			}
+			fmt.Fprintf(w, `{"data":{"id":"v2","type":"waf_firewall_version","attributes":{"number":2,"last_deployment_status":%q,"error":"boom"}}}`, status)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	var seen []string
+	v, err := c.DeployWAFVersionAndWait(context.Background(), &DeployWAFVersionAndWaitInput{
+		WAFID:            "WAF_ID",
+		WAFVersionNumber: 2,
+		PollInterval:     time.Millisecond,
+		Progress: func(v *WAFVersion) {
+			seen = append(seen, v.LastDeploymentStatus)
+		},
+	})
+	if !errors.Is(err, ErrWAFDeploymentFailed) {
+		t.Fatalf("bad error: %v", err)
+	}
+	if v == nil || v.Error != "boom" {
+		t.Errorf("bad version: %+v", v)
+	}
+	if !reflect.DeepEqual(seen, []string{WAFVersionDeploymentStatusInProgress, WAFVersionDeploymentStatusFailed}) {
+		t.Errorf("bad progress: %v", seen)
+	}
+}
+
+func TestClient_ActiveWAFVersion(t *testing.T) {
+	t.Parallel()
+
+	versions := `{"data":[{"id":"v1","type":"waf_firewall_version","attributes":{"number":1}},{"id":"v2","type":"waf_firewall_version","attributes":{"number":2,"active":true}}]}`
+	c := newTestServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/waf/firewalls/WAF_ID/versions" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(versions))
+	})
+
+	v, err := c.ActiveWAFVersion(&ActiveWAFVersionInput{WAFID: "WAF_ID"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Number != 2 {
+		t.Errorf("bad version: %d", v.Number)
+	}
+
+	versions = `{"data":[{"id":"v1","type":"waf_firewall_version","attributes":{"number":1}}]}`
+	if _, err := c.ActiveWAFVersion(&ActiveWAFVersionInput{WAFID: "WAF_ID"}); err != ErrNoActiveWAFVersion {
+		t.Errorf("bad error: %v", err)
+	}
+
+	if _, err := testClient.ActiveWAFVersion(&ActiveWAFVersionInput{}); err != ErrMissingWAFID {
+		t.Errorf("bad error: %v", err)
+	}
+}